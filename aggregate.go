@@ -0,0 +1,70 @@
+package dbkit
+
+import (
+	"github.com/kitstack/dbkit/connector/drivers/aggregates"
+	"github.com/kitstack/dbkit/specs"
+)
+
+type aggregate struct {
+	function string
+	from     string
+	alias    string
+}
+
+func (a *aggregate) Function() string {
+	return a.function
+}
+
+func (a *aggregate) From() string {
+	return a.from
+}
+
+// Alias returns the name used to scan the aggregate into the result struct, the field path by default.
+func (a *aggregate) Alias() string {
+	if a.alias == "" {
+		return a.from
+	}
+	return a.alias
+}
+
+func (a *aggregate) As(alias string) specs.Aggregate {
+	a.alias = alias
+	return a
+}
+
+func newAggregate(function string, from string) specs.Aggregate {
+	return &aggregate{
+		function: function,
+		from:     from,
+	}
+}
+
+// Count counts the non-null values of the field.
+func Count(from string) specs.Aggregate {
+	return newAggregate(aggregates.Count, from)
+}
+
+// Sum adds up the values of the field.
+func Sum(from string) specs.Aggregate {
+	return newAggregate(aggregates.Sum, from)
+}
+
+// Avg averages the values of the field.
+func Avg(from string) specs.Aggregate {
+	return newAggregate(aggregates.Avg, from)
+}
+
+// Min returns the lowest value of the field.
+func Min(from string) specs.Aggregate {
+	return newAggregate(aggregates.Min, from)
+}
+
+// Max returns the highest value of the field.
+func Max(from string) specs.Aggregate {
+	return newAggregate(aggregates.Max, from)
+}
+
+// Aggregate runs the builder with the aggregates and scans each row into R, see FindAllInto for the mapping of R.
+func Aggregate[R any, T specs.Model](builder specs.Builder[T], aggregates ...specs.Aggregate) ([]R, error) {
+	return FindAllInto[R](builder.SetAggregates(aggregates...))
+}
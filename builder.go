@@ -2,20 +2,54 @@ package dbkit
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/kitstack/dbkit/connector/drivers"
+	"github.com/kitstack/dbkit/connector/drivers/compounds"
+	"github.com/kitstack/dbkit/connector/drivers/directions"
+	"github.com/kitstack/dbkit/connector/drivers/locks"
 	"github.com/kitstack/dbkit/connector/drivers/operators"
 	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/depkit"
+	"iter"
+	"net/url"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
 var (
 	QueryTypeGet     = "Get"
 	QueryTypeFindAll = "FindAll"
 	QueryTypeFind    = "Find"
+	QueryTypeCreate  = "Create"
+	QueryTypeUpsert  = "Upsert"
+	QueryTypeUpdate  = "Update"
+	QueryTypeDelete  = "Delete"
+	QueryTypeCount   = "Count"
+	QueryTypeExists  = "Exists"
+
+	QueryTypeFindAllInto = "FindAllInto"
+	QueryTypeSubquery    = "Subquery"
+	QueryTypeFindPage    = "FindPage"
+	QueryTypeFindEach    = "FindEach"
+	QueryTypeCreateMany  = "CreateMany"
+	QueryTypeRaw         = "Raw"
 )
 
+// errSeqStopped stops the scan of FindSeq when the loop breaks, it never reaches the caller.
+var errSeqStopped = errors.New("the iteration has been stopped")
+
+// compound is a builder combined with the query of another builder by a set operator.
+type compound[T specs.Model] struct {
+	operator specs.CompoundOperator
+	builder  specs.Builder[T]
+}
+
 type builder[T specs.Model] struct {
 	sync.Mutex
 
@@ -31,12 +65,35 @@ type builder[T specs.Model] struct {
 
 	fields []string
 	wheres []specs.Condition
+	orders []string
+	groups []string
+	having []specs.Condition
+
+	aggregates []specs.Aggregate
+	compounds  []compound[T]
+
+	unconditional bool
+	withTrashed   bool
+	onlyTrashed   bool
+	distinct      bool
 
 	selectedFieldsDefinition []specs.FieldDefinition
+	whereFieldsDefinition    []specs.FieldDefinition
+	orderFieldsDefinition    []specs.FieldDefinition
+	groupFieldsDefinition    []specs.FieldDefinition
+	intoFieldsDefinition     []specs.FieldDefinition
 
 	driverFields []specs.DriverField
 	driverJoins  []specs.DriverJoin
 	driverWheres []specs.DriverWhere
+	driverValues []specs.DriverWhere
+	driverLimit  specs.DriverLimit
+	driverLock   specs.DriverLock
+	driverOrders []specs.DriverOrder
+	driverGroups []specs.DriverField
+	driverHaving []specs.DriverWhere
+
+	driverCompounds []specs.DriverCompound
 
 	payload specs.PayloadAugmented[T]
 }
@@ -76,7 +133,7 @@ func (o *builder[T]) execute(flow ...func() error) (err error) {
 }
 
 func (o *builder[T]) valideRequiredField() error {
-	if len(o.selectedFieldsDefinition) > 0 {
+	if len(o.selectedFieldsDefinition) > 0 || len(o.driverFields) > 0 {
 		return nil
 	}
 
@@ -102,190 +159,1668 @@ func (o *builder[T]) buildFields() (err error) {
 	return
 }
 
-func (o *builder[T]) buildWheres() (err error) {
-	for _, where := range o.wheres {
-
-		fieldDefinition, err := o.modelDefinition.GetFieldByName(where.From())
+// buildIntoFields resolves the columns of a projection, each path is either the alias of an aggregate or a field of the model.
+func (o *builder[T]) buildIntoFields(paths []string) (err error) {
+	for _, path := range paths {
+		field, err := o.getSelectableField(path)
 		if err != nil {
 			return err
 		}
 
-		o.driverWheres = append(o.driverWheres, drivers.NewWhere().SetFrom(fieldDefinition.Field()).SetOperator(where.Operator()).SetTo(where.To()))
+		o.driverFields = append(o.driverFields, field)
 	}
 
 	return
 }
 
-func (o *builder[T]) getDriverFields() []specs.DriverField {
+// getSelectableField returns the driver field of an aggregate alias or of a field path.
+func (o *builder[T]) getSelectableField(path string) (specs.DriverField, error) {
+	field, ok, err := o.getAggregateField(path)
+	if ok || err != nil {
+		return field, err
+	}
 
-	for _, field := range o.selectedFieldsDefinition {
-		o.driverFields = append(o.driverFields, field.Field())
+	return o.getProjectionField(path)
+}
+
+// getAggregateField returns the driver field of the aggregate selected under the alias, if any.
+func (o *builder[T]) getAggregateField(alias string) (specs.DriverField, bool, error) {
+	for _, aggregate := range o.aggregates {
+		if aggregate.Alias() != alias {
+			continue
+		}
+
+		field, err := o.getProjectionField(aggregate.From())
+		if err != nil {
+			return nil, true, err
+		}
+
+		return drivers.NewField().SetName(alias).SetCustom(fmt.Sprintf("%s(${field})", aggregate.Function()), []specs.DriverField{field.SetName("field")}), true, nil
 	}
 
-	return o.driverFields
+	return nil, false, nil
 }
 
-func (o *builder[T]) getDriverJoins() ([]specs.DriverJoin, error) {
+// getProjectionField returns the driver field of a field path,
+// the fields from a slice relation are refused as they would multiply the rows.
+func (o *builder[T]) getProjectionField(path string) (specs.DriverField, error) {
+	fieldDefinition, err := o.modelDefinition.GetFieldByName(path)
+	if err != nil {
+		return nil, err
+	}
 
-	uniqueJoins := map[string]specs.DriverJoin{}
-	for _, field := range o.selectedFieldsDefinition {
+	if fieldDefinition.FromSlice() {
+		return nil, NewFieldNotSelectableError(o.QueryType(), path)
+	}
 
-		for _, join := range field.Join() {
-			formatted, err := join.Formatted()
-			if err != nil {
-				return nil, err
-			}
-			uniqueJoins[formatted] = join
-		}
+	o.intoFieldsDefinition = append(o.intoFieldsDefinition, fieldDefinition)
+
+	return fieldDefinition.Field(), nil
+}
 
+func (o *builder[T]) valideRequiredCondition() error {
+	if len(o.driverWheres) > 0 || o.unconditional {
+		return nil
 	}
 
-	for _, join := range uniqueJoins {
-		o.driverJoins = append(o.driverJoins, join)
+	return NewConditionRequiredError(o.QueryType())
+}
+
+func (o *builder[T]) valideRequiredValue() error {
+	if len(o.driverValues) > 0 {
+		return nil
 	}
 
-	return o.driverJoins, nil
+	return NewFieldRequiredError(o.QueryType())
 }
 
-func (o *builder[T]) getDriverWheres() []specs.DriverWhere {
-	return o.driverWheres
+// getColumnFields returns the fields of the model mapped to one of its own columns.
+func (o *builder[T]) getColumnFields() (fields []specs.FieldDefinition) {
+	for _, field := range o.modelDefinition.Fields() {
+		if field.Model() != o.modelDefinition || field.Column() == "" {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return
 }
 
-func (o *builder[T]) buildPayload() error {
-	o.payload = depkit.Get[specs.NewPayload[T]]()(o.model)
-	o.payload.SetFields(o.getDriverFields())
-	o.payload.SetWheres(o.getDriverWheres())
+// getRelationFields returns the non slice relations declared on the model itself (e.g. `CommentsModel.User`).
+func (o *builder[T]) getRelationFields() (fields []specs.FieldDefinition) {
+	visited := map[specs.FieldDefinition]bool{}
+	for _, field := range o.modelDefinition.Fields() {
+		relation := field.Model().FromField()
+		if relation == nil || visited[relation] {
+			continue
+		}
+		visited[relation] = true
 
-	joins, err := o.getDriverJoins()
+		if relation.Model() != o.modelDefinition || relation.IsSlice() || relation.Column() == "" {
+			continue
+		}
+
+		fields = append(fields, relation)
+	}
+
+	return
+}
+
+// now returns the current time in the location configured on the connector.
+func (o *builder[T]) now() (time.Time, error) {
+	locale, err := url.QueryUnescape(o.Connector().Config().Locale())
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
-	o.payload.SetJoins(joins)
+	location, err := time.LoadLocation(locale)
+	if err != nil {
+		return time.Time{}, err
+	}
 
-	return nil
+	return time.Now().In(location), nil
 }
 
-func (o *builder[T]) Payload() specs.PayloadAugmented[T] {
-	return o.payload
+// buildCreateTimestamps fills the zero fields tagged `autoCreateTime` or `autoUpdateTime` with the current time.
+func (o *builder[T]) buildCreateTimestamps() error {
+	return o.setTimestamps(func(field specs.FieldDefinition) bool {
+		return (field.IsAutoCreateTime() || field.IsAutoUpdateTime()) && field.Value().IsZero()
+	})
 }
 
-func (o *builder[T]) Get(primaryKeyValue any) (result T, err error) {
-	o.setQueryType(QueryTypeGet)
+// buildUpdateTimestamps refreshes the fields tagged `autoUpdateTime` with the current time.
+func (o *builder[T]) buildUpdateTimestamps() error {
+	return o.setTimestamps(func(field specs.FieldDefinition) bool {
+		return field.IsAutoUpdateTime()
+	})
+}
 
-	primaryField, err := o.modelDefinition.GetPrimaryField()
-	if err != nil {
-		return
-	}
+// setTimestamps writes the current time into the model fields accepted by filter, a field which is neither
+// a time.Time nor a *time.Time is left untouched.
+func (o *builder[T]) setTimestamps(filter func(field specs.FieldDefinition) bool) error {
+	var now *time.Time
+	for _, field := range o.getColumnFields() {
+		if !filter(field) {
+			continue
+		}
 
-	o.SetWhere(NewCondition().SetFrom(primaryField.RecursiveFullName()).
-		SetOperator(operators.Equal).
-		SetTo(primaryKeyValue))
+		if now == nil {
+			current, err := o.now()
+			if err != nil {
+				return err
+			}
+			now = &current
+		}
 
-	return o.Find()
+		value := reflect.New(field.Value().Type())
+		switch v := value.Interface().(type) {
+		case *time.Time:
+			*v = *now
+		case **time.Time:
+			current := *now
+			*v = &current
+		default:
+			continue
+		}
+
+		field.Set(value.Interface())
+	}
+
+	return nil
 }
 
-func (o *builder[T]) Find() (result T, err error) {
-	data, err := o.setQueryType(QueryTypeFind).FindAll()
-	if err != nil {
-		return
+func (o *builder[T]) buildCreateValues() (err error) {
+	columns := map[string]bool{}
+	for _, field := range o.getColumnFields() {
+		if field.IsPrimaryKey() && field.Value().IsZero() {
+			continue
+		}
+
+		columns[field.Column()] = true
+		o.driverValues = append(o.driverValues, newDriverValue(field.Field(), field.Get()))
 	}
 
-	if len(data) == 0 {
-		err = NewNotFoundError(o.modelDefinition.TypeName())
-		return
+	// The foreign key of a relation is only written when the model has no field of its own for that column,
+	// the value comes from the referenced field of the embedded model (e.g. `User.Id` for `user_id`).
+	for _, relation := range o.getRelationFields() {
+		if columns[relation.Column()] {
+			continue
+		}
+
+		to, err := relation.GetToColumn()
+		if err != nil {
+			return err
+		}
+
+		if to.Value().IsZero() {
+			continue
+		}
+
+		columns[relation.Column()] = true
+		o.driverValues = append(o.driverValues, newDriverValue(drivers.NewField().SetColumn(relation.Column()).SetIndex(o.modelDefinition.Index()), to.Get()))
 	}
 
-	return data[0], nil
+	return
 }
 
-func (o *builder[T]) FindAll() ([]T, error) {
-	o.setQueryType(QueryTypeFindAll)
+func (o *builder[T]) buildUpdateValues() (err error) {
+	fields := o.getColumnFields()
 
-	err := o.execute(
-		o.buildFields,
-		o.valideRequiredField,
-		o.buildWheres,
-		o.buildPayload,
-	)
+	if len(o.fields) > 0 {
+		fields = nil
+		for _, fieldName := range o.fields {
+			field, err := o.modelDefinition.GetFieldByName(fieldName)
+			if err != nil {
+				return err
+			}
 
-	if err != nil {
-		return nil, err
-	}
+			if field.Model() != o.modelDefinition || field.Column() == "" {
+				return NewFieldNotWritableError(o.QueryType(), fieldName)
+			}
 
-	err = o.Connector().Select(o.Context(), o.Payload())
-	if err != nil {
-		return nil, err
+			fields = append(fields, field)
+		}
+
+		// The fields tagged `autoUpdateTime` are refreshed by every update, selected or not.
+		for _, field := range o.getColumnFields() {
+			if field.IsAutoUpdateTime() && !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
 	}
 
-	err = o.SubBuilder().Execute()
-	if err != nil {
-		return nil, err
+	for _, field := range fields {
+		// The version is written by Update itself, incremented.
+		if field.IsPrimaryKey() || field.IsVersion() {
+			continue
+		}
+
+		// The creation time is kept as it is, unless the field is explicitly selected.
+		if field.IsAutoCreateTime() && len(o.fields) == 0 {
+			continue
+		}
+
+		o.driverValues = append(o.driverValues, newDriverValue(field.Field(), field.Get()))
 	}
 
-	return o.Payload().Result(), nil
+	return
 }
 
-func (o *builder[T]) Delete(_ any) error {
-	//TODO implement me
-	panic("implement me")
-}
+func (o *builder[T]) buildWheres() (err error) {
+	for _, where := range o.wheres {
+		driverWhere, err := o.buildWhere(where, false)
+		if err != nil {
+			return err
+		}
 
-func (o *builder[T]) Create() (err error) {
-	//TODO implement me
-	panic("implement me")
-}
+		o.driverWheres = append(o.driverWheres, driverWhere)
+	}
 
-func (o *builder[T]) Update() error {
-	//TODO implement me
-	panic("implement me")
+	return
 }
 
-func (o *builder[T]) SetFields(field ...string) specs.Builder[T] {
-	o.fields = field
-	return o
-}
+// buildSoftDeleteWhere excludes the soft deleted rows of a model tagged `softDelete`, or keeps only them with OnlyTrashed.
+func (o *builder[T]) buildSoftDeleteWhere() error {
+	field := o.modelDefinition.GetSoftDeleteField()
+	if field == nil || o.withTrashed {
+		return nil
+	}
 
-func (o *builder[T]) SetWhere(where specs.Condition) specs.Builder[T] {
-	o.wheres = append(o.wheres, where)
-	return o
+	operator := operators.IsNull
+	if o.onlyTrashed {
+		operator = operators.IsNotNull
+	}
+
+	o.driverWheres = append(o.driverWheres, drivers.NewWhere().SetFrom(field.Field()).SetOperator(operator))
+
+	return nil
 }
 
-func (o *builder[T]) Wheres() []specs.Condition {
-	return o.wheres
+func (o *builder[T]) buildHaving() (err error) {
+	for _, having := range o.having {
+		driverWhere, err := o.buildWhere(having, true)
+		if err != nil {
+			return err
+		}
+
+		o.driverHaving = append(o.driverHaving, driverWhere)
+	}
+
+	return
 }
 
-func (o *builder[T]) Fields() []string {
-	return o.fields
+func (o *builder[T]) buildGroupBy() (err error) {
+	for _, fieldName := range o.groups {
+		fieldDefinition, err := o.modelDefinition.GetFieldByName(fieldName)
+		if err != nil {
+			return err
+		}
+
+		if fieldDefinition.FromSlice() {
+			return NewFieldNotSelectableError(o.QueryType(), fieldName)
+		}
+
+		o.groupFieldsDefinition = append(o.groupFieldsDefinition, fieldDefinition)
+		o.driverGroups = append(o.driverGroups, fieldDefinition.Field())
+	}
+
+	return
 }
 
-func (o *builder[T]) SetLimit(_ int) specs.Builder[T] {
-	//TODO implement me
-	panic("implement me")
+// buildWhere resolves a condition into its driver where, recursively for the groups.
+// Only a HAVING condition refers to an aggregate by its alias, a WHERE one is always resolved on the fields of the model.
+func (o *builder[T]) buildWhere(where specs.Condition, having bool) (specs.DriverWhere, error) {
+	if where.IsGroup() {
+		var driverWheres []specs.DriverWhere
+		for _, current := range where.Conditions() {
+			driverWhere, err := o.buildWhere(current, having)
+			if err != nil {
+				return nil, err
+			}
+
+			driverWheres = append(driverWheres, driverWhere)
+		}
+
+		return drivers.NewWhere().SetOperator(where.Operator()).SetWheres(driverWheres...), nil
+	}
+
+	if where.Operator() == operators.Exists || where.Operator() == operators.NotExists {
+		return o.buildExists(where)
+	}
+
+	var from specs.DriverField
+	ok := false
+	if having {
+		var err error
+		from, ok, err = o.getAggregateField(where.From())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !ok {
+		fieldDefinition, err := o.modelDefinition.GetFieldByName(where.From())
+		if err != nil {
+			return nil, err
+		}
+
+		o.whereFieldsDefinition = append(o.whereFieldsDefinition, fieldDefinition)
+		from = fieldDefinition.Field()
+	}
+
+	to := where.To()
+	switch value := to.(type) {
+	case specs.FieldPath:
+		toDefinition, err := o.modelDefinition.GetFieldByName(string(value))
+		if err != nil {
+			return nil, err
+		}
+
+		o.whereFieldsDefinition = append(o.whereFieldsDefinition, toDefinition)
+		to = toDefinition.Field()
+	case specs.Subquery:
+		payload, err := value.Subquery(o.getNextIndex())
+		if err != nil {
+			return nil, err
+		}
+
+		to = payload
+	}
+
+	return drivers.NewWhere().SetFrom(from).SetOperator(where.Operator()).SetTo(to), nil
 }
 
-func (o *builder[T]) SetOffset(_ int) specs.Builder[T] {
-	//TODO implement me
-	panic("implement me")
+// buildExists resolves an EXISTS or NOT EXISTS condition, the subquery is correlated on the relation given by From (e.g. `Comments`),
+// without From it is left uncorrelated.
+func (o *builder[T]) buildExists(where specs.Condition) (specs.DriverWhere, error) {
+	subquery, ok := where.To().(specs.Subquery)
+	if !ok {
+		return nil, NewSubqueryRequiredError(o.QueryType(), where.Operator())
+	}
+
+	payload, err := subquery.Subquery(o.getNextIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	if where.From() != "" {
+		correlation, err := o.getCorrelation(where.From(), payload)
+		if err != nil {
+			return nil, err
+		}
+
+		payload.SetWheres(append(payload.Where(), correlation))
+	}
+
+	return drivers.NewWhere().SetOperator(where.Operator()).SetTo(payload), nil
 }
 
-func (o *builder[T]) SetOrderBy(_ ...string) specs.Builder[T] {
-	//TODO implement me
-	panic("implement me")
+// getCorrelation returns the where binding the subquery to a relation of the model, the same way the join of the relation would.
+func (o *builder[T]) getCorrelation(path string, subquery specs.Payload) (specs.DriverWhere, error) {
+	for _, field := range o.modelDefinition.Fields() {
+		relation := field.Model().FromField()
+		if relation == nil || relation.RecursiveFullName() != path {
+			continue
+		}
+
+		if field.Model().DatabaseName() != subquery.Database() || field.Model().TableName() != subquery.Table() {
+			break
+		}
+
+		// The field holding the column of the relation brings the joins up to its model, a slice relation has none of its own.
+		column, err := relation.Model().GetFieldByColumn(relation.Tags()["column"])
+		if err != nil {
+			return nil, err
+		}
+
+		o.whereFieldsDefinition = append(o.whereFieldsDefinition, column)
+
+		return drivers.NewWhere().
+			SetFrom(drivers.NewField().SetIndex(subquery.Index()).SetColumn(relation.Tags()["foreignKey"])).
+			SetOperator(operators.Equal).
+			SetTo(column.Field()), nil
+	}
+
+	return nil, NewRelationMismatchError(o.QueryType(), path, subquery.Table())
 }
 
-func (o *builder[T]) Count() (total int64, err error) {
-	//TODO implement me
-	panic("implement me")
+// getNextIndex returns the first table alias left unused by the model, a subquery starts its own aliases from there.
+func (o *builder[T]) getNextIndex() int {
+	index := o.modelDefinition.Index()
+	for _, field := range o.modelDefinition.Fields() {
+		if field.Index() > index {
+			index = field.Index()
+		}
+	}
+
+	return index + 1
 }
 
-func (o *builder[T]) SetModel(model T) specs.Builder[T] {
-	o.model = model
-	o.modelDefinition = depkit.Get[specs.UseModelDefinition]()(model).Parse()
+// buildOrderBy resolves the ordering paths, a `-` prefix sorts the field in descending order (e.g. `-User.Email`).
+func (o *builder[T]) buildOrderBy() (err error) {
+	for _, path := range o.orders {
+		direction := specs.OrderDirection(directions.Asc)
+		if strings.HasPrefix(path, "-") {
+			direction = directions.Desc
+		}
 
-	depkit.Register[specs.NewPayload[T]](NewPayload[T])
+		fieldName := strings.TrimLeft(path, "+-")
 
-	return o
+		field, ok, err := o.getAggregateField(fieldName)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			fieldDefinition, err := o.modelDefinition.GetFieldByName(fieldName)
+			if err != nil {
+				return err
+			}
+
+			if fieldDefinition.FromSlice() {
+				return NewFieldNotSortableError(o.QueryType(), fieldName)
+			}
+
+			o.orderFieldsDefinition = append(o.orderFieldsDefinition, fieldDefinition)
+			field = fieldDefinition.Field()
+		}
+
+		o.driverOrders = append(o.driverOrders, drivers.NewOrder().SetField(field).SetDirection(direction))
+	}
+
+	return
+}
+
+// buildCompounds builds the query of each combined builder, a builder without fields selects the same fields
+// as this one so that the rows of both queries have the same columns.
+func (o *builder[T]) buildCompounds() error {
+	if len(o.compounds) == 0 {
+		return nil
+	}
+
+	var paths []string
+	for _, field := range o.driverFields {
+		paths = append(paths, field.Name())
+	}
+	for _, field := range o.selectedFieldsDefinition {
+		paths = append(paths, field.RecursiveFullName())
+	}
+
+	for _, compound := range o.compounds {
+		if len(compound.builder.Fields()) == 0 {
+			compound.builder.SetFields(paths...)
+		}
+
+		payload, err := compound.builder.Subquery(o.getNextIndex())
+		if err != nil {
+			return err
+		}
+
+		o.driverCompounds = append(o.driverCompounds, drivers.NewCompound().SetOperator(compound.operator).SetPayload(payload))
+	}
+
+	return nil
+}
+
+func (o *builder[T]) getDriverFields() []specs.DriverField {
+
+	for _, field := range o.selectedFieldsDefinition {
+		o.driverFields = append(o.driverFields, field.Field())
+	}
+
+	return o.driverFields
+}
+
+// getDriverJoins returns the joins required by every field path used in the query, in order of appearance and without duplicates.
+func (o *builder[T]) getDriverJoins() ([]specs.DriverJoin, error) {
+
+	uniqueJoins := map[string]bool{}
+	var fields []specs.FieldDefinition
+	fields = append(fields, o.selectedFieldsDefinition...)
+	fields = append(fields, o.whereFieldsDefinition...)
+	fields = append(fields, o.orderFieldsDefinition...)
+	fields = append(fields, o.groupFieldsDefinition...)
+	fields = append(fields, o.intoFieldsDefinition...)
+
+	for _, field := range fields {
+
+		for _, join := range field.Join() {
+			if o.withTrashed {
+				join.SetWheres()
+			}
+
+			formatted, err := join.Formatted()
+			if err != nil {
+				return nil, err
+			}
+
+			if uniqueJoins[formatted] {
+				continue
+			}
+			uniqueJoins[formatted] = true
+
+			o.driverJoins = append(o.driverJoins, join)
+		}
+
+	}
+
+	return o.driverJoins, nil
+}
+
+func (o *builder[T]) getDriverWheres() []specs.DriverWhere {
+	return o.driverWheres
+}
+
+// reset drops the state derived from the fields, the conditions and the orders by a previous query,
+// the builder then builds its payload again from scratch.
+func (o *builder[T]) reset() {
+	o.selectedFieldsDefinition = nil
+	o.whereFieldsDefinition = nil
+	o.orderFieldsDefinition = nil
+	o.groupFieldsDefinition = nil
+	o.intoFieldsDefinition = nil
+
+	o.driverFields = nil
+	o.driverJoins = nil
+	o.driverWheres = nil
+	o.driverValues = nil
+	o.driverOrders = nil
+	o.driverGroups = nil
+	o.driverHaving = nil
+	o.driverCompounds = nil
+
+	o.payload = nil
+}
+
+func (o *builder[T]) buildPayload() error {
+	o.payload = depkit.Get[specs.NewPayload[T]]()(o.model)
+	o.payload.SetFields(o.getDriverFields())
+	o.payload.SetWheres(o.getDriverWheres())
+	o.payload.SetValues(o.driverValues)
+	o.payload.SetLimit(o.driverLimit)
+	o.payload.SetLock(o.driverLock)
+	o.payload.SetOrderBy(o.driverOrders)
+	o.payload.SetGroupBy(o.driverGroups)
+	o.payload.SetHaving(o.driverHaving)
+	o.payload.SetDistinct(o.distinct)
+	o.payload.SetCompounds(o.driverCompounds)
+
+	joins, err := o.getDriverJoins()
+	if err != nil {
+		return err
+	}
+
+	o.payload.SetJoins(joins)
+
+	return nil
+}
+
+func (o *builder[T]) Payload() specs.PayloadAugmented[T] {
+	return o.payload
+}
+
+func (o *builder[T]) Get(primaryKeyValue any) (result T, err error) {
+	o.setQueryType(QueryTypeGet)
+
+	primaryField, err := o.modelDefinition.GetPrimaryField()
+	if err != nil {
+		return
+	}
+
+	o.SetWhere(NewCondition().SetFrom(primaryField.RecursiveFullName()).
+		SetOperator(operators.Equal).
+		SetTo(primaryKeyValue))
+
+	return o.Find()
+}
+
+func (o *builder[T]) Find() (result T, err error) {
+	data, err := o.setQueryType(QueryTypeFind).SetLimit(1).FindAll()
+	if err != nil {
+		return
+	}
+
+	if len(data) == 0 {
+		err = NewNotFoundError(o.modelDefinition.TypeName())
+		return
+	}
+
+	return data[0], nil
+}
+
+func (o *builder[T]) FindAll() ([]T, error) {
+	o.setQueryType(QueryTypeFindAll)
+
+	err := o.execute(
+		o.buildFields,
+		o.valideRequiredField,
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.buildGroupBy,
+		o.buildHaving,
+		o.buildOrderBy,
+		o.buildCompounds,
+		o.valideLock,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.Connector().Select(o.Context(), o.Payload())
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.SubBuilder().Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Payload().Result(), nil
+}
+
+// FindEach runs the query and hands each row to fn as soon as it is scanned, instead of accumulating the result,
+// an error returned by fn stops the scan and is returned. The fields of a slice relation are refused as they are
+// loaded once every row has been read.
+func (o *builder[T]) FindEach(fn func(T) error) error {
+	o.setQueryType(QueryTypeFindEach)
+
+	err := o.execute(
+		o.valideStreamableFields,
+		o.buildFields,
+		o.valideRequiredField,
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.buildGroupBy,
+		o.buildHaving,
+		o.buildOrderBy,
+		o.buildCompounds,
+		o.valideLock,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return o.Connector().Select(o.Context(), o.Payload().SetEach(fn))
+}
+
+// FindSeq is the iterator form of FindEach, the scan stops when the loop breaks.
+// An error of the query is yielded once, with the zero value of the model.
+func (o *builder[T]) FindSeq() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		err := o.FindEach(func(item T) error {
+			if !yield(item, nil) {
+				return errSeqStopped
+			}
+			return nil
+		})
+
+		if err != nil && !errors.Is(err, errSeqStopped) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// valideLock refuses a locking read outside a transaction, the rows would be released as soon as the query ends.
+// A combined query can not be locked, the lock would apply to the result of the set operation.
+func (o *builder[T]) valideLock() error {
+	if o.driverLock == nil {
+		return nil
+	}
+
+	if len(o.compounds) > 0 {
+		return NewLockCompoundError(o.QueryType(), compounds.Operator[o.compounds[0].operator])
+	}
+
+	if _, ok := drivers.TxFromContext(o.Context(), o.Connector().Get()); ok {
+		return nil
+	}
+
+	return NewTransactionRequiredError(o.QueryType())
+}
+
+func (o *builder[T]) valideStreamableFields() error {
+	for _, fieldName := range o.fields {
+		field, err := o.modelDefinition.GetFieldByName(fieldName)
+		if err != nil {
+			return err
+		}
+
+		if field.FromSlice() {
+			return NewFieldNotSelectableError(o.QueryType(), fieldName)
+		}
+	}
+
+	return nil
+}
+
+// FindPage returns a page of at most size rows following the cursor, an empty cursor starts from the first row.
+// The rows are ordered by SetOrderBy completed by the primary key to keep the order stable, the cursor is turned
+// back into a comparison on those keys instead of an offset, a nullable key is refused.
+func (o *builder[T]) FindPage(cursor string, size int) (specs.Page[T], error) {
+	o.setQueryType(QueryTypeFindPage)
+
+	if size < 1 {
+		return nil, NewPageSizeError(o.QueryType(), size)
+	}
+
+	orders, keys, err := o.getPageKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	// The page is read with copies of the fields, the conditions, the orders and the limit, the builder keeps the ones
+	// of the caller and drops the state derived by the query so that it can read another page.
+	fields, wheres, sorts, limit := o.fields, o.wheres, o.orders, o.driverLimit
+	o.fields, o.wheres, o.driverLimit = slices.Clone(fields), slices.Clone(wheres), drivers.NewLimit()
+	if limit != nil {
+		o.driverLimit.SetOffset(limit.Offset())
+	}
+	defer func() {
+		o.fields, o.wheres, o.orders, o.driverLimit = fields, wheres, sorts, limit
+		o.reset()
+	}()
+
+	backward := false
+	if cursor != "" {
+		var values []any
+		values, backward, err = decodeCursor(cursor, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		o.SetWhere(newKeysetCondition(orders, values, backward))
+	}
+
+	// A backward page is read in the reverse order from the cursor, then put back in order.
+	queryOrders := make([]string, len(orders))
+	for i, order := range orders {
+		queryOrders[i] = order
+		if backward {
+			queryOrders[i] = reverseOrder(order)
+		}
+	}
+
+	// The keys are selected for the cursors to be read from the rows.
+	selected := map[string]bool{}
+	for _, field := range o.fields {
+		selected[field] = true
+	}
+
+	for _, order := range orders {
+		path := strings.TrimLeft(order, "+-")
+		if !selected[path] {
+			o.fields = append(o.fields, path)
+		}
+	}
+
+	items, err := o.SetOrderBy(queryOrders...).SetLimit(size + 1).FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(items) > size
+	if hasMore {
+		items = items[:size]
+	}
+
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	if len(items) == 0 {
+		return newPage[T](items, "", ""), nil
+	}
+
+	var next, previous string
+	if hasMore || backward {
+		next, err = o.getPageCursor(items[len(items)-1], orders, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if (hasMore && backward) || (!backward && cursor != "") {
+		previous, err = o.getPageCursor(items[0], orders, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newPage[T](items, next, previous), nil
+}
+
+// getPageKeys returns the orders of a page and the fields of their keys, the primary key is appended unless already ordered.
+func (o *builder[T]) getPageKeys() (orders []string, keys []specs.FieldDefinition, err error) {
+	primaryField, err := o.modelDefinition.GetPrimaryField()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stable := false
+	for _, order := range o.orders {
+		key, err := o.modelDefinition.GetFieldByName(strings.TrimLeft(order, "+-"))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// A NULL key can not be compared, the rows holding it would never be reached by a cursor.
+		if key.Value().Kind() == reflect.Ptr {
+			return nil, nil, NewNullableKeyError(o.QueryType(), key.RecursiveFullName())
+		}
+
+		stable = stable || key == primaryField
+		orders = append(orders, order)
+		keys = append(keys, key)
+	}
+
+	if !stable {
+		orders = append(orders, primaryField.RecursiveFullName())
+		keys = append(keys, primaryField)
+	}
+
+	return orders, keys, nil
+}
+
+// getPageCursor returns the cursor positioned on the row.
+func (o *builder[T]) getPageCursor(item T, orders []string, backward bool) (string, error) {
+	definition := depkit.Get[specs.UseModelDefinition]()(item).Parse()
+
+	var keys []specs.FieldDefinition
+	for _, order := range orders {
+		key, err := definition.GetFieldByName(strings.TrimLeft(order, "+-"))
+		if err != nil {
+			return "", err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return encodeCursor(keys, backward)
+}
+
+// reverseOrder returns the path of the order sorted in the other direction.
+func reverseOrder(order string) string {
+	if strings.HasPrefix(order, "-") {
+		return strings.TrimLeft(order, "+-")
+	}
+
+	return "-" + strings.TrimLeft(order, "+-")
+}
+
+// FindAllInto runs the query and scans the rows into the payload, the columns are given by its paths
+// instead of the selected fields, an aggregate is selected when its alias matches a path.
+func (o *builder[T]) FindAllInto(payload specs.PayloadInto) error {
+	o.setQueryType(QueryTypeFindAllInto)
+
+	err := o.execute(
+		func() error {
+			return o.buildIntoFields(payload.Paths())
+		},
+		o.valideRequiredField,
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.buildGroupBy,
+		o.buildHaving,
+		o.buildOrderBy,
+		o.buildCompounds,
+		o.valideLock,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return o.Connector().Select(o.Context(), payload.SetPayload(o.Payload()))
+}
+
+// Subquery builds the payload of the builder to be rendered inside the query of another builder, without running it.
+// The model is parsed again with its aliases starting at the given index, a subquery without fields selects `1`.
+func (o *builder[T]) Subquery(index int) (specs.Payload, error) {
+	o.setQueryType(QueryTypeSubquery)
+
+	// The subquery is built again each time the query holding it is.
+	o.reset()
+	o.modelDefinition = depkit.Get[specs.UseModelDefinition]()(o.model).SetIndex(index).Parse()
+
+	err := o.execute(
+		func() error {
+			return o.buildIntoFields(o.fields)
+		},
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.buildGroupBy,
+		o.buildHaving,
+		o.buildOrderBy,
+		o.buildCompounds,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(o.driverFields) == 0 {
+		o.Payload().SetFields([]specs.DriverField{drivers.NewField().SetCustom("1", nil)})
+	}
+
+	return newSubquery(o.Payload(), index), nil
+}
+
+func (o *builder[T]) Delete(primaryKeyValue any) error {
+	o.setQueryType(QueryTypeDelete)
+
+	primaryField, err := o.modelDefinition.GetPrimaryField()
+	if err != nil {
+		return err
+	}
+
+	o.SetWhere(NewCondition().SetFrom(primaryField.RecursiveFullName()).
+		SetOperator(operators.Equal).
+		SetTo(primaryKeyValue))
+
+	_, err = o.DeleteWhere()
+
+	return err
+}
+
+// DeleteWhere removes every row matching the conditions and returns the number of rows affected, the rows of a model
+// tagged `softDelete` are stamped with the deletion time instead. Without any condition the query is refused,
+// unless AllowUnconditional has been called.
+func (o *builder[T]) DeleteWhere() (int64, error) {
+	o.setQueryType(QueryTypeDelete)
+
+	softDelete := o.modelDefinition.GetSoftDeleteField()
+
+	err := o.execute(
+		o.buildWheres,
+		o.valideRequiredCondition,
+		o.buildSoftDeleteWhere,
+		func() error {
+			if softDelete == nil {
+				return nil
+			}
+
+			now, err := o.now()
+			if err != nil {
+				return err
+			}
+
+			o.driverValues = append(o.driverValues, newDriverValue(softDelete.Field(), now))
+			return nil
+		},
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var result sql.Result
+	if softDelete != nil {
+		result, err = o.Connector().Update(o.Context(), o.Payload())
+	} else {
+		result, err = o.Connector().Delete(o.Context(), o.Payload())
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (o *builder[T]) Create() (err error) {
+	o.setQueryType(QueryTypeCreate)
+
+	err = o.execute(
+		o.buildCreateTimestamps,
+		o.buildCreateValues,
+		o.valideRequiredValue,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	result, err := o.Connector().Insert(o.Context(), o.Payload())
+	if err != nil {
+		return err
+	}
+
+	return o.setInsertedPrimaryKey(result)
+}
+
+// CreateMany inserts the models with multi-row statements of at most batchSize rows, consecutive models writing
+// the same columns share a statement. The ids generated by each statement are written back into the primary keys,
+// counting from its first insert id. The statements run in the transaction of the context, or in a new one,
+// so that either every model is inserted or none.
+func (o *builder[T]) CreateMany(models []T, batchSize int) error {
+	o.setQueryType(QueryTypeCreateMany)
+
+	if batchSize < 1 {
+		return NewBatchSizeError(o.QueryType(), batchSize)
+	}
+
+	if _, ok := drivers.TxFromContext(o.Context(), o.Connector().Get()); ok || len(models) == 0 {
+		return o.createMany(models, batchSize)
+	}
+
+	return o.Connector().Transaction(o.Context(), func(ctx context.Context) error {
+		defer func(previous context.Context) {
+			o.context = previous
+		}(o.context)
+
+		o.context = ctx
+		return o.createMany(models, batchSize)
+	})
+}
+
+// createMany groups the models into batches and inserts them.
+func (o *builder[T]) createMany(models []T, batchSize int) error {
+	var batch []T
+	var rows [][]specs.DriverWhere
+	var columns string
+	for _, model := range models {
+		values, err := o.getCreateValues(model)
+		if err != nil {
+			return err
+		}
+
+		var current []string
+		for _, value := range values {
+			current = append(current, value.From().Column())
+		}
+
+		if len(batch) > 0 && (strings.Join(current, ",") != columns || len(batch) == batchSize) {
+			if err := o.createBatch(batch, rows); err != nil {
+				return err
+			}
+
+			batch, rows = nil, nil
+		}
+
+		columns = strings.Join(current, ",")
+		batch = append(batch, model)
+		rows = append(rows, values)
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	return o.createBatch(batch, rows)
+}
+
+// getCreateValues returns the values the model would be created with, as Create does.
+func (o *builder[T]) getCreateValues(model T) ([]specs.DriverWhere, error) {
+	o.SetModel(model)
+	o.driverValues = nil
+
+	err := o.execute(
+		o.buildCreateTimestamps,
+		o.buildCreateValues,
+		o.valideRequiredValue,
+	)
+
+	return o.driverValues, err
+}
+
+// createBatch inserts the rows of a batch, the driver may split it into several statements.
+func (o *builder[T]) createBatch(batch []T, rows [][]specs.DriverWhere) error {
+	o.driverValues = nil
+
+	err := o.buildPayload()
+	if err != nil {
+		return err
+	}
+
+	results, err := o.Connector().InsertMany(o.Context(), o.Payload().SetRows(rows))
+	if err != nil {
+		return err
+	}
+
+	offset := 0
+	for _, result := range results {
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		lastInsertId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < int(rowsAffected) && offset < len(batch); i, offset = i+1, offset+1 {
+			primaryField, err := depkit.Get[specs.UseModelDefinition]()(batch[offset]).Parse().GetPrimaryField()
+			if err != nil || !primaryField.Value().IsZero() {
+				continue
+			}
+
+			setPrimaryKey(primaryField, lastInsertId+int64(i))
+		}
+	}
+
+	return nil
+}
+
+// Upsert inserts the model, or updates the existing row when the insert hits a duplicate key and reports which one happened.
+// On a duplicate key the fields given to SetFields are updated, by default every inserted column except the primary key.
+func (o *builder[T]) Upsert() (inserted bool, err error) {
+	o.setQueryType(QueryTypeUpsert)
+
+	err = o.execute(
+		o.buildCreateTimestamps,
+		o.buildUpdateTimestamps,
+		o.buildCreateValues,
+		o.valideRequiredValue,
+		o.buildUpsertFields,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	result, err := o.Connector().Upsert(o.Context(), o.Payload())
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, o.setInsertedPrimaryKey(result)
+}
+
+// buildUpsertFields resolves the columns updated on a duplicate key, only the columns of the model itself are writable.
+// The columns tagged `autoCreateTime` keep the time of the first insert unless they are given to SetFields.
+func (o *builder[T]) buildUpsertFields() error {
+	if len(o.fields) == 0 {
+		kept := map[string]bool{}
+		for _, field := range o.getColumnFields() {
+			if field.IsPrimaryKey() || field.IsAutoCreateTime() {
+				kept[field.Column()] = true
+			}
+		}
+
+		for _, value := range o.driverValues {
+			if kept[value.From().Column()] {
+				continue
+			}
+
+			o.driverFields = append(o.driverFields, value.From())
+		}
+
+		return nil
+	}
+
+	for _, fieldName := range o.fields {
+		field, err := o.modelDefinition.GetFieldByName(fieldName)
+		if err != nil {
+			return err
+		}
+
+		if field.Model() != o.modelDefinition || field.Column() == "" {
+			return NewFieldNotWritableError(o.QueryType(), fieldName)
+		}
+
+		if field.IsPrimaryKey() {
+			continue
+		}
+
+		o.driverFields = append(o.driverFields, field.Field())
+	}
+
+	return nil
+}
+
+// setInsertedPrimaryKey writes the auto-increment value generated by the database back into the primary key of the model.
+func (o *builder[T]) setInsertedPrimaryKey(result sql.Result) error {
+	primaryField, err := o.modelDefinition.GetPrimaryField()
+	if err != nil || !primaryField.Value().IsZero() {
+		return nil
+	}
+
+	lastInsertId, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	setPrimaryKey(primaryField, lastInsertId)
+
+	return nil
+}
+
+// setPrimaryKey writes the generated id into the primary key, left untouched when it is not an integer.
+func setPrimaryKey(primaryField specs.FieldDefinition, id int64) {
+	value := reflect.New(primaryField.Value().Type())
+	switch value.Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.Elem().SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.Elem().SetUint(uint64(id))
+	default:
+		return
+	}
+
+	primaryField.Set(value.Interface())
+}
+
+// Update writes the model into the row of its primary key. When the model has a field tagged `version`, the row is
+// only written at the version of the model which is then incremented, ErrStaleObject is returned when no row matches.
+func (o *builder[T]) Update() error {
+	o.setQueryType(QueryTypeUpdate)
+
+	primaryField, err := o.modelDefinition.GetPrimaryField()
+	if err != nil {
+		return err
+	}
+
+	if primaryField.Value().IsZero() {
+		return NewPrimaryKeyRequiredError(o.QueryType(), o.modelDefinition.TypeName())
+	}
+
+	o.SetWhere(NewCondition().SetFrom(primaryField.RecursiveFullName()).
+		SetOperator(operators.Equal).
+		SetTo(primaryField.Get()))
+
+	versionField := o.modelDefinition.GetVersionField()
+	var version reflect.Value
+	if versionField != nil {
+		version, err = o.getNextVersion(versionField)
+		if err != nil {
+			return err
+		}
+
+		o.SetWhere(NewCondition().SetFrom(versionField.RecursiveFullName()).
+			SetOperator(operators.Equal).
+			SetTo(versionField.Get()))
+	}
+
+	err = o.execute(
+		o.buildUpdateTimestamps,
+		o.buildUpdateValues,
+		o.valideRequiredValue,
+		func() error {
+			if versionField != nil {
+				o.driverValues = append(o.driverValues, newDriverValue(versionField.Field(), version.Elem().Interface()))
+			}
+			return nil
+		},
+		o.buildWheres,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	result, err := o.Connector().Update(o.Context(), o.Payload())
+	if err != nil || versionField == nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return NewErrStaleObject(o.modelDefinition.TypeName(), versionField.Get())
+	}
+
+	versionField.Set(version.Interface())
+
+	return nil
+}
+
+// getNextVersion returns a pointer to the version following the one of the model, the version field must be an integer.
+func (o *builder[T]) getNextVersion(versionField specs.FieldDefinition) (reflect.Value, error) {
+	current := versionField.Value()
+	next := reflect.New(current.Type())
+	switch current.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		next.Elem().SetInt(current.Int() + 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		next.Elem().SetUint(current.Uint() + 1)
+	default:
+		return reflect.Value{}, NewVersionFieldError(o.QueryType(), versionField.RecursiveFullName())
+	}
+
+	return next, nil
+}
+
+// UpdateWhere sets the values of the field paths on every row matching the conditions and returns the number of rows affected.
+// A value given with Field copies another field of the row instead. Without any condition the query is refused,
+// unless AllowUnconditional has been called.
+func (o *builder[T]) UpdateWhere(values map[string]any) (int64, error) {
+	o.setQueryType(QueryTypeUpdate)
+
+	err := o.execute(
+		func() error {
+			return o.buildUpdateWhereValues(values)
+		},
+		o.valideRequiredValue,
+		o.buildUpdateWhereTimestamps,
+		o.buildWheres,
+		o.valideRequiredCondition,
+		o.buildSoftDeleteWhere,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := o.Connector().Update(o.Context(), o.Payload())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// buildUpdateWhereValues resolves the assignments of UpdateWhere, sorted by path to keep the query stable.
+func (o *builder[T]) buildUpdateWhereValues(values map[string]any) error {
+	paths := make([]string, 0, len(values))
+	for path := range values {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		field, err := o.modelDefinition.GetFieldByName(path)
+		if err != nil {
+			return err
+		}
+
+		if field.Model() != o.modelDefinition || field.Column() == "" {
+			return NewFieldNotWritableError(o.QueryType(), path)
+		}
+
+		value := values[path]
+		if fieldPath, ok := value.(specs.FieldPath); ok {
+			valueDefinition, err := o.modelDefinition.GetFieldByName(string(fieldPath))
+			if err != nil {
+				return err
+			}
+
+			// The joins of the copied field are required like the ones of a condition.
+			o.whereFieldsDefinition = append(o.whereFieldsDefinition, valueDefinition)
+			value = valueDefinition.Field()
+		}
+
+		o.driverValues = append(o.driverValues, newDriverValue(field.Field(), value))
+	}
+
+	return nil
+}
+
+// buildUpdateWhereTimestamps refreshes the columns tagged `autoUpdateTime` which are not given a value by UpdateWhere.
+func (o *builder[T]) buildUpdateWhereTimestamps() error {
+	for _, field := range o.getColumnFields() {
+		if !field.IsAutoUpdateTime() || slices.ContainsFunc(o.driverValues, func(value specs.DriverWhere) bool {
+			return value.From().Column() == field.Column()
+		}) {
+			continue
+		}
+
+		now, err := o.now()
+		if err != nil {
+			return err
+		}
+
+		o.driverValues = append(o.driverValues, newDriverValue(field.Field(), now))
+	}
+
+	return nil
+}
+
+func (o *builder[T]) SetFields(field ...string) specs.Builder[T] {
+	o.fields = field
+	return o
+}
+
+func (o *builder[T]) SetWhere(where specs.Condition) specs.Builder[T] {
+	o.wheres = append(o.wheres, where)
+	return o
+}
+
+// SetGroupBy groups the rows by the given field paths.
+func (o *builder[T]) SetGroupBy(fields ...string) specs.Builder[T] {
+	o.groups = fields
+	return o
+}
+
+// SetHaving filters the groups, the condition can refer to an aggregate by its alias.
+func (o *builder[T]) SetHaving(condition specs.Condition) specs.Builder[T] {
+	o.having = append(o.having, condition)
+	return o
+}
+
+// SetAggregates declares the aggregates available to FindAllInto, SetHaving and SetOrderBy by their alias.
+func (o *builder[T]) SetAggregates(aggregates ...specs.Aggregate) specs.Builder[T] {
+	o.aggregates = aggregates
+	return o
+}
+
+// AllowUnconditional allows the writing queries to run without any condition, affecting every row of the table.
+func (o *builder[T]) AllowUnconditional() specs.Builder[T] {
+	o.unconditional = true
+	return o
+}
+
+// WithTrashed includes the soft deleted rows, of the model and of its joined models, in the query.
+func (o *builder[T]) WithTrashed() specs.Builder[T] {
+	o.withTrashed = true
+	return o
+}
+
+// OnlyTrashed restricts the query to the soft deleted rows of the model.
+func (o *builder[T]) OnlyTrashed() specs.Builder[T] {
+	o.onlyTrashed = true
+	return o
+}
+
+// LockForUpdate locks the selected rows against the updates and the locking reads of the other transactions,
+// the option tells how to handle the rows already locked (e.g. `locks.SkipLocked`). It requires a transaction in the context
+// and can not be combined with Union, UnionAll, Intersect or Except.
+func (o *builder[T]) LockForUpdate(option ...specs.LockOption) specs.Builder[T] {
+	return o.setLock(locks.ForUpdate, option)
+}
+
+// LockForShare locks the selected rows against the updates of the other transactions, which can still read them.
+// It requires a transaction in the context and can not be combined like LockForUpdate.
+func (o *builder[T]) LockForShare(option ...specs.LockOption) specs.Builder[T] {
+	return o.setLock(locks.ForShare, option)
+}
+
+func (o *builder[T]) setLock(strength specs.LockStrength, option []specs.LockOption) specs.Builder[T] {
+	o.driverLock = drivers.NewLock().SetStrength(strength)
+	if len(option) > 0 {
+		o.driverLock.SetOption(option[0])
+	}
+
+	return o
+}
+
+// SetDistinct removes the duplicate rows from the result.
+func (o *builder[T]) SetDistinct() specs.Builder[T] {
+	o.distinct = true
+	return o
+}
+
+// Union combines the rows of the other builder with the rows of this one, without the duplicates.
+// The ordering and the limit of this builder apply to the combined result, the fields it orders by must be selected.
+func (o *builder[T]) Union(other specs.Builder[T]) specs.Builder[T] {
+	return o.combine(compounds.Union, other)
+}
+
+// UnionAll combines the rows of the other builder with the rows of this one, the duplicates included.
+func (o *builder[T]) UnionAll(other specs.Builder[T]) specs.Builder[T] {
+	return o.combine(compounds.UnionAll, other)
+}
+
+// Intersect keeps the rows of this builder which are also returned by the other builder.
+func (o *builder[T]) Intersect(other specs.Builder[T]) specs.Builder[T] {
+	return o.combine(compounds.Intersect, other)
+}
+
+// Except keeps the rows of this builder which are not returned by the other builder.
+func (o *builder[T]) Except(other specs.Builder[T]) specs.Builder[T] {
+	return o.combine(compounds.Except, other)
+}
+
+func (o *builder[T]) combine(operator specs.CompoundOperator, other specs.Builder[T]) specs.Builder[T] {
+	o.compounds = append(o.compounds, compound[T]{operator: operator, builder: other})
+	return o
+}
+
+func (o *builder[T]) Wheres() []specs.Condition {
+	return o.wheres
+}
+
+func (o *builder[T]) Fields() []string {
+	return o.fields
+}
+
+func (o *builder[T]) getDriverLimit() specs.DriverLimit {
+	if o.driverLimit == nil {
+		o.driverLimit = drivers.NewLimit()
+	}
+
+	return o.driverLimit
+}
+
+// SetLimit caps the number of rows read, a limit of 0 (the default) reads every row.
+// Combined with SetOffset only, the offset rows are skipped and all the remaining ones are read.
+func (o *builder[T]) SetLimit(limit int) specs.Builder[T] {
+	o.getDriverLimit().SetLimit(limit)
+	return o
+}
+
+// SetOffset skips the given number of rows, with or without SetLimit.
+func (o *builder[T]) SetOffset(offset int) specs.Builder[T] {
+	o.getDriverLimit().SetOffset(offset)
+	return o
+}
+
+// SetOrderBy sorts the result by the given field paths, prefixed by `-` for a descending order.
+func (o *builder[T]) SetOrderBy(fields ...string) specs.Builder[T] {
+	o.orders = fields
+	return o
+}
+
+// Count returns the number of rows matching the conditions, the selected fields, the ordering and the limit are ignored.
+// A condition on a slice relation counts each row once, the grouped, distinct and combined queries are refused.
+func (o *builder[T]) Count() (total int64, err error) {
+	o.setQueryType(QueryTypeCount)
+
+	err = o.execute(
+		o.valideCountable,
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.buildCountFields,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return
+	}
+
+	return o.Connector().Count(o.Context(), o.Payload())
+}
+
+// valideCountable refuses the clauses changing what a row of the result is, as Count counts the rows of the model.
+func (o *builder[T]) valideCountable() error {
+	switch {
+	case len(o.groups) > 0:
+		return NewClauseNotSupportedError(o.QueryType(), "GROUP BY")
+	case len(o.having) > 0:
+		return NewClauseNotSupportedError(o.QueryType(), "HAVING")
+	case o.distinct:
+		return NewClauseNotSupportedError(o.QueryType(), "DISTINCT")
+	case len(o.compounds) > 0:
+		return NewClauseNotSupportedError(o.QueryType(), compounds.Operator[o.compounds[0].operator])
+	}
+
+	return nil
+}
+
+// buildCountFields counts the distinct primary keys when a condition joins a slice relation,
+// each row of the model being repeated for every item of the relation.
+func (o *builder[T]) buildCountFields() error {
+	for _, field := range o.whereFieldsDefinition {
+		if !field.FromSlice() {
+			continue
+		}
+
+		primaryField, err := o.modelDefinition.GetPrimaryField()
+		if err != nil {
+			return err
+		}
+
+		o.driverFields = append(o.driverFields, primaryField.Field())
+		return nil
+	}
+
+	return nil
+}
+
+// Exists reports whether a row matches the conditions, it selects `1` with a limit of one row instead of the fields.
+func (o *builder[T]) Exists() (bool, error) {
+	o.setQueryType(QueryTypeExists)
+
+	err := o.execute(
+		o.buildWheres,
+		o.buildSoftDeleteWhere,
+		o.valideLock,
+		o.buildPayload,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	o.Payload().SetFields([]specs.DriverField{drivers.NewField().SetCustom("1", nil)})
+	o.Payload().SetLimit(drivers.NewLimit().SetLimit(1))
+
+	payload := newExists(o.Payload())
+
+	err = o.Connector().Select(o.Context(), payload)
+	if err != nil {
+		return false, err
+	}
+
+	return payload.found, nil
+}
+
+func (o *builder[T]) SetModel(model T) specs.Builder[T] {
+	o.model = model
+	o.modelDefinition = depkit.Get[specs.UseModelDefinition]()(model).Parse()
+
+	depkit.Register[specs.NewPayload[T]](NewPayload[T])
+
+	return o
+}
+
+// newDriverValue returns the assignment of a value to a column, used for writing queries.
+func newDriverValue(field specs.DriverField, value any) specs.DriverWhere {
+	return drivers.NewWhere().SetFrom(field).SetOperator(operators.Equal).SetTo(value)
 }
 
 func Use[T specs.Model](ctx context.Context, connector specs.Connector) specs.Builder[T] {
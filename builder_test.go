@@ -2,14 +2,23 @@ package dbkit
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"github.com/kitstack/dbkit/connector/config"
+	"github.com/kitstack/dbkit/connector/drivers"
+	"github.com/kitstack/dbkit/connector/drivers/locks"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
 	"github.com/kitstack/dbkit/definitions"
 	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/dbkit/tests/mocks"
+	"github.com/kitstack/dbkit/tests/mocks/fakesql"
 	"github.com/kitstack/dbkit/tests/models"
 	"github.com/kitstack/depkit"
 	"github.com/stretchr/testify/mock"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -41,6 +50,7 @@ type BuilderTestSuite struct {
 func (test *BuilderTestSuite) SetupTest() {
 	test.Context = context.Background()
 	test.fakeConnector = mocks.NewFakeConnector(test.T())
+	test.fakeConnector.On("Config").Return(config.New()).Maybe()
 	test.fakeModelDefinition = mocks.NewFakeModelDefinition(test.T())
 	test.fakeFieldDefinition = mocks.NewFakeFieldDefinition(test.T())
 	test.fakeUseModelDefinition = mocks.NewFakeUseModelDefinition(test.T())
@@ -67,6 +77,48 @@ func (test *BuilderTestSuite) SetupTest() {
 	depkit.Register[specs.NewSubBuilder[*models.PostsModel]](test.fakeNewSubBuilder.NewSubBuilder)
 }
 
+// useDefinitions replaces the fake model definitions by the real ones, to build queries from the test models.
+func (test *BuilderTestSuite) useDefinitions() {
+	depkit.Reset()
+	depkit.Register[specs.UseModelDefinition](definitions.Use)
+	depkit.Register[specs.NewPayload[*models.CommentsModel]](NewPayload[*models.CommentsModel])
+	depkit.Register[specs.NewPayload[*models.PostsModel]](NewPayload[*models.PostsModel])
+	depkit.Register[specs.NewPayload[*models.UsersModel]](NewPayload[*models.UsersModel])
+	depkit.Register[specs.NewPayload[*models.NotesModel]](NewPayload[*models.NotesModel])
+}
+
+// useTransaction expects a transaction to be opened on the connector, fn running with the context of the test.
+func (test *BuilderTestSuite) useTransaction() {
+	test.fakeConnector.On("Get").Return(nil)
+	test.fakeConnector.On("Transaction", test.Context, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+// formatSubquery returns the table alias of the subquery given as value of the where, along with its formatted joins and wheres,
+// the statement itself is rendered by the driver.
+func (test *BuilderTestSuite) formatSubquery(where specs.DriverWhere) (index int, joins []string, wheres []string, args []any) {
+	subquery, ok := where.To().(specs.Payload)
+	if !test.True(ok) {
+		return
+	}
+
+	for _, join := range subquery.Join() {
+		formatted, err := join.Formatted()
+		test.NoError(err)
+		joins = append(joins, formatted)
+	}
+
+	for _, current := range subquery.Where() {
+		formatted, currentArgs, err := current.Formatted()
+		test.NoError(err)
+		wheres = append(wheres, formatted)
+		args = append(args, currentArgs...)
+	}
+
+	return subquery.Index(), joins, wheres, args
+}
+
 func (test *BuilderTestSuite) TestGetWithNoPrimaryKeyErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
@@ -141,6 +193,7 @@ func (test *BuilderTestSuite) TestBuildWhereErr() {
 func (test *BuilderTestSuite) TestGetWithNotFoundErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
 	if !test.NotEmpty(builderInstance) {
@@ -154,7 +207,7 @@ func (test *BuilderTestSuite) TestGetWithNotFoundErr() {
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 
-	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Once()
+	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Twice() // for build fields and build where
 
 	test.fakeFieldDefinition.On("RecursiveFullName").Return("Id").Once()
 	test.fakeFieldDefinition.On("FromSlice").Return(false).Once()
@@ -164,6 +217,14 @@ func (test *BuilderTestSuite) TestGetWithNotFoundErr() {
 	test.fakeCommentPayloadConstruct.On("NewPayload", (*models.CommentsModel)(nil)).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetDistinct", false).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetJoins", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("Result").Return([]*models.CommentsModel{})
 
@@ -178,6 +239,7 @@ func (test *BuilderTestSuite) TestGetWithNotFoundErr() {
 func (test *BuilderTestSuite) TestGetSelectErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
 	if !test.NotEmpty(builderInstance) {
@@ -193,11 +255,19 @@ func (test *BuilderTestSuite) TestGetSelectErr() {
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 
-	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Once()
+	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Twice() // for build fields and build where
 
 	test.fakeCommentPayloadConstruct.On("NewPayload", (*models.CommentsModel)(nil)).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetDistinct", false).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetJoins", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 
 	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(errors.New("select_return_err"))
@@ -210,6 +280,7 @@ func (test *BuilderTestSuite) TestGetSelectErr() {
 func (test *BuilderTestSuite) TestBuildPayloadJoinErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
 	if !test.NotEmpty(builderInstance) {
@@ -230,6 +301,14 @@ func (test *BuilderTestSuite) TestBuildPayloadJoinErr() {
 	test.fakeCommentPayloadConstruct.On("NewPayload", (*models.CommentsModel)(nil)).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetDistinct", false).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 
 	test.fakeDriverJoin.On("Formatted").Return("", errors.New("join_err")).Once()
 
@@ -241,6 +320,7 @@ func (test *BuilderTestSuite) TestBuildPayloadJoinErr() {
 func (test *BuilderTestSuite) TestGet() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
 	if !test.NotEmpty(builderInstance) {
@@ -255,12 +335,20 @@ func (test *BuilderTestSuite) TestGet() {
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 
-	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{test.fakeDriverJoin}).Once()
-	test.fakeDriverJoin.On("Formatted").Return("JOIN `comments` ON `comments`.`id` = `posts`.`id`", nil).Once()
+	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{test.fakeDriverJoin}).Twice() // for build fields and build where
+	test.fakeDriverJoin.On("Formatted").Return("JOIN `comments` ON `comments`.`id` = `posts`.`id`", nil).Twice()
 
 	test.fakeCommentPayloadConstruct.On("NewPayload", (*models.CommentsModel)(nil)).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetDistinct", false).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakeCommentPayloadAugmented)
+	test.fakeCommentPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("SetJoins", mock.Anything).Return(test.fakeCommentPayloadAugmented)
 	test.fakeCommentPayloadAugmented.On("Result").Return([]*models.CommentsModel{{Id: 1}})
 
@@ -303,6 +391,7 @@ func (test *BuilderTestSuite) TestFields() {
 func (test *BuilderTestSuite) TestSubBuilder() {
 	test.fakeUseModelDefinition.On("Use", (*models.PostsModel)(nil)).Return(test.fakeModelDefinition).Once()
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition).Once()
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	test.fakeNewSubBuilder.On("NewSubBuilder").Return(test.fakeSubBuilder).Once()
 
@@ -328,11 +417,19 @@ func (test *BuilderTestSuite) TestSubBuilder() {
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 
-	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Once()
+	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Twice() // for build fields and build where
 
 	test.fakePostPayloadConstruct.On("NewPayload", (*models.PostsModel)(nil)).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetDistinct", false).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetJoins", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 
 	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(nil).Once()
@@ -353,6 +450,7 @@ func (test *BuilderTestSuite) TestSubBuilder() {
 func (test *BuilderTestSuite) TestSubBuilderErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.PostsModel)(nil)).Return(test.fakeModelDefinition).Once()
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition).Once()
+	test.fakeModelDefinition.On("GetSoftDeleteField").Return(nil)
 
 	test.fakeNewSubBuilder.On("NewSubBuilder").Return(test.fakeSubBuilder).Once()
 
@@ -378,11 +476,19 @@ func (test *BuilderTestSuite) TestSubBuilderErr() {
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
 
-	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Once()
+	test.fakeFieldDefinition.On("Join").Return([]specs.DriverJoin{}).Twice() // for build fields and build where
 
 	test.fakePostPayloadConstruct.On("NewPayload", (*models.PostsModel)(nil)).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetFields", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetWheres", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetValues", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetLimit", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetLock", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetDistinct", false).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetCompounds", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetOrderBy", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetGroupBy", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
+	test.fakePostPayloadAugmented.On("SetHaving", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 	test.fakePostPayloadAugmented.On("SetJoins", mock.Anything).Return(test.fakePostPayloadAugmented).Once()
 
 	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(nil).Once()
@@ -396,20 +502,33 @@ func (test *BuilderTestSuite) TestSubBuilderErr() {
 }
 
 func (test *BuilderTestSuite) TestDelete() {
-	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
-	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	test.useDefinitions()
 
-	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
-	if !test.NotEmpty(builderInstance) {
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Delete", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).Delete(3)
+	if !test.NoError(err) {
 		return
 	}
 
-	test.Panics(func() {
-		_ = builderInstance.Delete("Primary")
-	})
+	if !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`id` = ?", where)
+	test.Equal([]any{3}, args)
+	test.Empty(payload.Join())
 }
 
-func (test *BuilderTestSuite) TestCreate() {
+func (test *BuilderTestSuite) TestDeleteWithNoPrimaryKeyErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
@@ -417,67 +536,603 @@ func (test *BuilderTestSuite) TestCreate() {
 		return
 	}
 
-	test.Panics(func() {
-		_ = builderInstance.Create()
-	})
+	test.fakeModelDefinition.On("GetPrimaryField").Return(nil, definitions.NewErrNoPrimaryField(nil)).Once()
+
+	err := builderInstance.Delete(3)
+
+	primaryErr := &definitions.ErrPrimaryFieldNotFound{}
+	test.True(errors.As(err, &primaryErr))
 }
 
-func (test *BuilderTestSuite) TestUpdate() {
-	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
-	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
-	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
-	if !test.NotEmpty(builderInstance) {
+func (test *BuilderTestSuite) TestDeleteWhere() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(2), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Delete", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	rowsAffected, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("test@test.com")).
+		DeleteWhere()
+	if !test.NoError(err) {
 		return
 	}
 
-	test.Panics(func() {
-		_ = builderInstance.Update()
-	})
+	test.EqualValues(2, rowsAffected)
+
+	if !test.Len(payload.Where(), 1) || !test.Len(payload.Join(), 1) {
+		return
+	}
+
+	where, _, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t1`.`email` = ?", where)
+
+	join, err := payload.Join()[0].Formatted()
+	test.NoError(err)
+	test.Equal("JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", join)
 }
 
-func (test *BuilderTestSuite) TestLimit() {
-	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
-	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+func (test *BuilderTestSuite) TestDeleteWhereWithoutConditionErr() {
+	test.useDefinitions()
 
-	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
-	if !test.NotEmpty(builderInstance) {
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).DeleteWhere()
+
+	conditionErr := &ConditionRequiredError{}
+	test.True(errors.As(err, &conditionErr))
+	test.EqualError(err, "the method `Delete` requires one or more conditions, use AllowUnconditional to affect every row")
+}
+
+func (test *BuilderTestSuite) TestDeleteWhereAllowUnconditional() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(5), nil).Once()
+	test.fakeConnector.On("Delete", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+
+	rowsAffected, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).AllowUnconditional().DeleteWhere()
+	test.NoError(err)
+	test.EqualValues(5, rowsAffected)
+}
+
+func (test *BuilderTestSuite) TestDeleteWhereBuildWhereErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Unknown").SetOperator(operators.Equal).SetTo(1)).
+		DeleteWhere()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestDeleteErr() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Delete", test.Context, mock.Anything).Return(nil, errors.New("delete_err")).Once()
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).Delete(3)
+	test.EqualError(err, "delete_err")
+}
+
+func (test *BuilderTestSuite) formatWheres(payload specs.Payload) (wheres []string) {
+	for _, where := range payload.Where() {
+		formatted, _, err := where.Formatted()
+		test.NoError(err)
+		wheres = append(wheres, formatted)
+	}
+	return
+}
+
+func (test *BuilderTestSuite) formatJoins(payload specs.Payload) (joins []string) {
+	for _, join := range payload.Join() {
+		formatted, err := join.Formatted()
+		test.NoError(err)
+		joins = append(joins, formatted)
+	}
+	return
+}
+
+func (test *BuilderTestSuite) TestLockForUpdate() {
+	test.useDefinitions()
+
+	db := new(sql.DB)
+	test.fakeConnector.On("Get").Return(db)
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.UsersModel](drivers.WithTx(test.Context, db, nil), test.fakeConnector).
+		SetFields("Id").
+		LockForUpdate(locks.SkipLocked).
+		FindAll()
+	if !test.NoError(err) {
 		return
 	}
 
-	test.Panics(func() {
-		_ = builderInstance.SetLimit(0)
-	})
+	formatted, err := payload.Lock().Formatted()
+	test.NoError(err)
+	test.Equal("FOR UPDATE SKIP LOCKED", formatted)
 }
 
-func (test *BuilderTestSuite) TestOffset() {
-	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
-	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+func (test *BuilderTestSuite) TestLockForShare() {
+	test.useDefinitions()
 
-	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
-	if !test.NotEmpty(builderInstance) {
+	db := new(sql.DB)
+	test.fakeConnector.On("Get").Return(db)
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	err := Use[*models.UsersModel](drivers.WithTx(test.Context, db, nil), test.fakeConnector).
+		SetFields("Id").
+		LockForShare().
+		FindEach(func(*models.UsersModel) error { return nil })
+	if !test.NoError(err) {
 		return
 	}
 
-	test.Panics(func() {
-		_ = builderInstance.SetOffset(0)
-	})
+	formatted, err := payload.Lock().Formatted()
+	test.NoError(err)
+	test.Equal("FOR SHARE", formatted)
 }
 
-func (test *BuilderTestSuite) TestOrderBy() {
+func (test *BuilderTestSuite) TestLockTransactionRequiredErr() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Get").Return(new(sql.DB))
+
+	_, err := Use[*models.UsersModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		LockForUpdate().
+		FindAll()
+	test.ErrorAs(err, new(*TransactionRequiredError))
+	test.EqualError(err, "the method `FindAll` requires a transaction in the context to lock the rows")
+}
+
+func (test *BuilderTestSuite) TestLockCompoundErr() {
+	test.useDefinitions()
+
+	db := new(sql.DB)
+	test.fakeConnector.On("Get").Return(db).Maybe()
+
+	_, err := Use[*models.UsersModel](drivers.WithTx(test.Context, db, nil), test.fakeConnector).
+		SetFields("Id").
+		Union(Use[*models.UsersModel](test.Context, test.fakeConnector)).
+		LockForUpdate().
+		FindAll()
+	test.ErrorAs(err, new(*LockCompoundError))
+	test.EqualError(err, "the method `FindAll` can not lock the rows of a query combined by `UNION`")
+}
+
+func (test *BuilderTestSuite) TestSoftDelete() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	err := Use[*models.NotesModel](test.Context, test.fakeConnector).Delete(1)
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"`t0`.`id` = ?", "`t0`.`deleted_at` IS NULL"}, test.formatWheres(payload))
+
+	if !test.Len(payload.Values(), 1) {
+		return
+	}
+
+	value, args, err := payload.Values()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`deleted_at` = ?", value)
+	if test.Len(args, 1) {
+		test.IsType(time.Time{}, args[0])
+	}
+}
+
+func (test *BuilderTestSuite) TestSoftDeleteErr() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(nil, errors.New("update_err")).Once()
+
+	err := Use[*models.NotesModel](test.Context, test.fakeConnector).Delete(1)
+	test.EqualError(err, "update_err")
+}
+
+func (test *BuilderTestSuite) TestSoftDeleteExcluded() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.NotesModel](test.Context, test.fakeConnector).
+		SetFields("Id", "Parent.Content").
+		FindAll()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"`t0`.`deleted_at` IS NULL"}, test.formatWheres(payload))
+	test.Equal([]string{
+		"JOIN `acceptance`.`notes` AS `t2` ON `t2`.`id` = `t0`.`parent_id` AND `t2`.`deleted_at` IS NULL",
+	}, test.formatJoins(payload))
+}
+
+func (test *BuilderTestSuite) TestSoftDeleteWithTrashed() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Count", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(int64(4), nil).Once()
+
+	_, err := Use[*models.NotesModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Parent.Content").SetOperator(operators.IsNotNull)).
+		WithTrashed().
+		Count()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"`t2`.`content` IS NOT NULL"}, test.formatWheres(payload))
+	test.Equal([]string{
+		"JOIN `acceptance`.`notes` AS `t2` ON `t2`.`id` = `t0`.`parent_id`",
+	}, test.formatJoins(payload))
+}
+
+func (test *BuilderTestSuite) TestSoftDeleteOnlyTrashed() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Count", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(int64(1), nil).Once()
+
+	_, err := Use[*models.NotesModel](test.Context, test.fakeConnector).OnlyTrashed().Count()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"`t0`.`deleted_at` IS NOT NULL"}, test.formatWheres(payload))
+}
+
+func (test *BuilderTestSuite) TestCreate() {
+	test.useDefinitions()
+
+	comment := &models.CommentsModel{User: models.UsersModel{Id: 2}, PostId: 1, Content: "test"}
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("LastInsertId").Return(int64(9), nil).Once()
+
+	var columns []string
+	var values []any
+	test.fakeConnector.On("Insert", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		for _, value := range args.Get(1).(specs.Payload).Values() {
+			columns = append(columns, value.From().Column())
+			values = append(values, value.To())
+		}
+	}).Return(fakeResult, nil).Once()
+
+	err := builderInstance.Create()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"post_id", "content", "created_at", "updated_at", "user_id"}, columns)
+	test.Equal(uint(1), values[0])
+	test.Equal("test", values[1])
+	test.Equal(uint(2), values[4])
+	test.Equal(uint(9), comment.Id)
+}
+
+func (test *BuilderTestSuite) TestCreateTimestamps() {
+	test.useDefinitions()
+
+	fakeConnector := mocks.NewFakeConnector(test.T())
+	fakeConnector.On("Config").Return(config.New().SetLocale("Europe/Paris"))
+
+	created := time.Date(2023, 3, 24, 2, 31, 25, 0, time.UTC)
+	user := &models.UsersModel{Id: 4, Email: "test@test.com", CreatedAt: &created}
+
+	var values []any
+	fakeConnector.On("Insert", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		for _, value := range args.Get(1).(specs.Payload).Values() {
+			values = append(values, value.To())
+		}
+	}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	err := Use[*models.UsersModel](test.Context, fakeConnector).SetModel(user).Create()
+	if !test.NoError(err) {
+		return
+	}
+
+	// A time already set is kept, the zero ones are filled in the location of the connector.
+	test.Equal(created, *user.CreatedAt)
+	if test.NotNil(user.UpdatedAt) {
+		test.Equal("Europe/Paris", user.UpdatedAt.Location().String())
+		test.Contains(values, user.UpdatedAt)
+	}
+}
+
+func (test *BuilderTestSuite) TestCreateTimestampsLocaleErr() {
+	test.useDefinitions()
+
+	fakeConnector := mocks.NewFakeConnector(test.T())
+	fakeConnector.On("Config").Return(config.New().SetLocale("Unknown/Location"))
+
+	err := Use[*models.CommentsModel](test.Context, fakeConnector).SetModel(&models.CommentsModel{Content: "test"}).Create()
+	test.EqualError(err, "unknown time zone Unknown/Location")
+}
+
+func (test *BuilderTestSuite) TestCreateWithPrimaryKey() {
+	test.useDefinitions()
+
+	user := &models.UsersModel{Id: 4, Email: "test@test.com"}
+	builderInstance := Use[*models.UsersModel](test.Context, test.fakeConnector).SetModel(user)
+
+	var columns []string
+	test.fakeConnector.On("Insert", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		for _, value := range args.Get(1).(specs.Payload).Values() {
+			columns = append(columns, value.From().Column())
+		}
+	}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	err := builderInstance.Create()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"id", "email", "password", "validated", "created_at", "updated_at"}, columns)
+	test.Equal(uint(4), user.Id)
+}
+
+func (test *BuilderTestSuite) TestCreateInsertErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{})
+
+	test.fakeConnector.On("Insert", test.Context, mock.Anything).Return(nil, errors.New("insert_err")).Once()
+
+	err := builderInstance.Create()
+	test.EqualError(err, "insert_err")
+}
+
+func (test *BuilderTestSuite) TestCreateLastInsertIdErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{})
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("LastInsertId").Return(int64(0), errors.New("last_insert_id_err")).Once()
+	test.fakeConnector.On("Insert", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+
+	err := builderInstance.Create()
+	test.EqualError(err, "last_insert_id_err")
+}
+
+func (test *BuilderTestSuite) TestCreateMany() {
+	test.useDefinitions()
+	test.useTransaction()
+
+	comments := []*models.CommentsModel{
+		{User: models.UsersModel{Id: 1}, PostId: 1, Content: "first"},
+		{User: models.UsersModel{Id: 2}, PostId: 1, Content: "second"},
+		{User: models.UsersModel{Id: 3}, PostId: 2, Content: "third"},
+		{Id: 20, User: models.UsersModel{Id: 1}, PostId: 2, Content: "fourth"},
+	}
+
+	var batches [][]int
+	newResult := func(rowsAffected int64, lastInsertId int64) *fakesql.Result {
+		fakeResult := fakesql.NewResult(test.T())
+		fakeResult.On("RowsAffected").Return(rowsAffected, nil).Once()
+		fakeResult.On("LastInsertId").Return(lastInsertId, nil).Once()
+		return fakeResult
+	}
+
+	capture := func(args mock.Arguments) {
+		var batch []int
+		for _, row := range args.Get(1).(specs.Payload).Rows() {
+			batch = append(batch, len(row))
+		}
+		batches = append(batches, batch)
+	}
+
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Run(capture).Return([]sql.Result{newResult(2, 10)}, nil).Once()
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Run(capture).Return([]sql.Result{newResult(1, 12)}, nil).Once()
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Run(capture).Return([]sql.Result{newResult(1, 20)}, nil).Once()
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 2)
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([][]int{{5, 5}, {5}, {6}}, batches)
+	test.Equal([]uint{10, 11, 12, 20}, []uint{comments[0].Id, comments[1].Id, comments[2].Id, comments[3].Id})
+	test.fakeConnector.AssertNumberOfCalls(test.T(), "Transaction", 1)
+}
+
+func (test *BuilderTestSuite) TestCreateManySplitByDriver() {
+	test.useDefinitions()
+	test.useTransaction()
+
+	comments := []*models.CommentsModel{
+		{User: models.UsersModel{Id: 1}, PostId: 1, Content: "first"},
+		{User: models.UsersModel{Id: 2}, PostId: 1, Content: "second"},
+		{User: models.UsersModel{Id: 3}, PostId: 2, Content: "third"},
+	}
+
+	first := fakesql.NewResult(test.T())
+	first.On("RowsAffected").Return(int64(1), nil).Once()
+	first.On("LastInsertId").Return(int64(4), nil).Once()
+
+	second := fakesql.NewResult(test.T())
+	second.On("RowsAffected").Return(int64(2), nil).Once()
+	second.On("LastInsertId").Return(int64(8), nil).Once()
+
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Return([]sql.Result{first, second}, nil).Once()
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 10)
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]uint{4, 8, 9}, []uint{comments[0].Id, comments[1].Id, comments[2].Id})
+}
+
+func (test *BuilderTestSuite) TestCreateManyInTransaction() {
+	test.useDefinitions()
+
+	db := new(sql.DB)
+	ctx := drivers.WithTx(test.Context, db, nil)
+	test.fakeConnector.On("Get").Return(db)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+	fakeResult.On("LastInsertId").Return(int64(4), nil).Once()
+
+	// The transaction of the context is used as it is, none is opened.
+	test.fakeConnector.On("InsertMany", ctx, mock.Anything).Return([]sql.Result{fakeResult}, nil).Once()
+
+	comments := []*models.CommentsModel{{User: models.UsersModel{Id: 1}, PostId: 1, Content: "first"}}
+	err := Use[*models.CommentsModel](ctx, test.fakeConnector).CreateMany(comments, 10)
+	test.NoError(err)
+	test.EqualValues(4, comments[0].Id)
+}
+
+func (test *BuilderTestSuite) TestCreateManyErr() {
+	test.useDefinitions()
+	test.useTransaction()
+
+	comments := []*models.CommentsModel{{Content: "first"}}
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 0)
+	test.EqualError(err, "the method `CreateMany` requires a batch size of at least 1, got 0")
+
+	test.NoError(Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(nil, 10))
+
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Return(nil, errors.New("insert_many_err")).Once()
+	err = Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 10)
+	test.EqualError(err, "insert_many_err")
+
+	rowsAffectedErr := fakesql.NewResult(test.T())
+	rowsAffectedErr.On("RowsAffected").Return(int64(0), errors.New("rows_affected_err")).Once()
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Return([]sql.Result{rowsAffectedErr}, nil).Once()
+	err = Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 10)
+	test.EqualError(err, "rows_affected_err")
+
+	lastInsertIdErr := fakesql.NewResult(test.T())
+	lastInsertIdErr.On("RowsAffected").Return(int64(1), nil).Once()
+	lastInsertIdErr.On("LastInsertId").Return(int64(0), errors.New("last_insert_id_err")).Once()
+	test.fakeConnector.On("InsertMany", test.Context, mock.Anything).Return([]sql.Result{lastInsertIdErr}, nil).Once()
+	err = Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany(comments, 10)
+	test.EqualError(err, "last_insert_id_err")
+}
+
+func (test *BuilderTestSuite) TestCreateManyWithoutValueErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
+	test.fakeUseModelDefinition.On("Use", &models.CommentsModel{}).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
-	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
-	if !test.NotEmpty(builderInstance) {
+	test.fakeModelDefinition.On("Fields").Return([]specs.FieldDefinition{})
+	test.useTransaction()
+
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).CreateMany([]*models.CommentsModel{{}}, 10)
+	test.ErrorContains(err, "the method `CreateMany` requires the selection of one or more fields")
+}
+
+func (test *BuilderTestSuite) TestUpsert() {
+	test.useDefinitions()
+
+	comment := &models.CommentsModel{User: models.UsersModel{Id: 2}, PostId: 1, Content: "test"}
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(2), nil).Once()
+	fakeResult.On("LastInsertId").Return(int64(7), nil).Once()
+
+	var columns, updates []string
+	test.fakeConnector.On("Upsert", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+		for _, value := range payload.Values() {
+			columns = append(columns, value.From().Column())
+		}
+		for _, field := range payload.Fields() {
+			updates = append(updates, field.Column())
+		}
+		test.Equal("id", payload.PrimaryKey().Column())
+	}).Return(fakeResult, nil).Once()
+
+	inserted, err := builderInstance.Upsert()
+	if !test.NoError(err) {
 		return
 	}
 
-	test.Panics(func() {
-		// @TODO Maybe pass SetOrderBy struct instead of string
-		_ = builderInstance.SetOrderBy("Id", "ASC")
-	})
+	test.False(inserted)
+	test.Equal([]string{"post_id", "content", "created_at", "updated_at", "user_id"}, columns)
+	test.Equal([]string{"post_id", "content", "updated_at", "user_id"}, updates)
+	test.Equal(uint(7), comment.Id)
+	test.False(comment.Created.IsZero())
+	test.False(comment.Updated.IsZero())
 }
 
-func (test *BuilderTestSuite) TestCount() {
+func (test *BuilderTestSuite) TestUpsertWithFields() {
+	test.useDefinitions()
+
+	user := &models.UsersModel{Id: 4, Email: "test@test.com"}
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+
+	var updates []string
+	test.fakeConnector.On("Upsert", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		for _, field := range args.Get(1).(specs.Payload).Fields() {
+			updates = append(updates, field.Column())
+		}
+	}).Return(fakeResult, nil).Once()
+
+	inserted, err := Use[*models.UsersModel](test.Context, test.fakeConnector).SetModel(user).SetFields("Id", "Email", "Validated").Upsert()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.True(inserted)
+	test.Equal([]string{"email", "validated"}, updates)
+}
+
+func (test *BuilderTestSuite) TestUpsertErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Content: "test"}).SetFields("User.Email").Upsert()
+	test.EqualError(err, "the method `Upsert` can not write the field `User.Email`, only the columns of the model itself are writable")
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Content: "test"}).SetFields("Unknown").Upsert()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+
+	test.fakeConnector.On("Upsert", test.Context, mock.Anything).Return(nil, errors.New("upsert_err")).Once()
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Content: "test"}).Upsert()
+	test.EqualError(err, "upsert_err")
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(0), errors.New("rows_affected_err")).Once()
+	test.fakeConnector.On("Upsert", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Content: "test"}).Upsert()
+	test.EqualError(err, "rows_affected_err")
+}
+
+func (test *BuilderTestSuite) TestCreateWithoutValueErr() {
 	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
 	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
 	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
@@ -485,9 +1140,1467 @@ func (test *BuilderTestSuite) TestCount() {
 		return
 	}
 
-	test.Panics(func() {
-		_, _ = builderInstance.Count()
-	})
+	test.fakeModelDefinition.On("Fields").Return([]specs.FieldDefinition{})
+
+	err := builderInstance.Create()
+	test.ErrorContains(err, "the method `Create` requires the selection of one or more fields")
+}
+
+func (test *BuilderTestSuite) TestUpdate() {
+	test.useDefinitions()
+
+	comment := &models.CommentsModel{Id: 3, PostId: 1, Content: "test"}
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment)
+
+	var payload specs.Payload
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	err := builderInstance.Update()
+	if !test.NoError(err) {
+		return
+	}
+
+	var columns []string
+	for _, value := range payload.Values() {
+		columns = append(columns, value.From().Column())
+	}
+	test.Equal([]string{"post_id", "content", "updated_at"}, columns)
+
+	if !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`id` = ?", where)
+	test.Equal([]any{uint(3)}, args)
+}
+
+func (test *BuilderTestSuite) TestUpdateVersion() {
+	test.useDefinitions()
+
+	post := &models.PostsModel{Id: 2, Title: "test", Version: 4}
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	err := Use[*models.PostsModel](test.Context, test.fakeConnector).SetModel(post).SetFields("Title").Update()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]string{"`t0`.`id` = ?", "`t0`.`version` = ?"}, test.formatWheres(payload))
+
+	var values []string
+	var args []any
+	for _, value := range payload.Values() {
+		formatted, valueArgs, err := value.Formatted()
+		test.NoError(err)
+		values = append(values, formatted)
+		args = append(args, valueArgs...)
+	}
+	test.Equal([]string{"`t0`.`title` = ?", "`t0`.`updated_at` = ?", "`t0`.`version` = ?"}, values)
+	test.Equal(uint(5), args[2])
+	test.Equal(uint(5), post.Version)
+}
+
+func (test *BuilderTestSuite) TestUpdateStaleObjectErr() {
+	test.useDefinitions()
+
+	post := &models.PostsModel{Id: 2, Title: "test", Version: 4}
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(0), nil).Once()
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+
+	err := Use[*models.PostsModel](test.Context, test.fakeConnector).SetModel(post).Update()
+
+	staleErr := &ErrStaleObject{}
+	test.True(errors.As(err, &staleErr))
+	test.EqualError(err, "the PostsModel at version 4 is stale, it has been updated or removed since it was read")
+	test.Equal(uint(4), post.Version)
+
+	fakeResult = fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(0), errors.New("rows_affected_err")).Once()
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+
+	err = Use[*models.PostsModel](test.Context, test.fakeConnector).SetModel(post).Update()
+	test.EqualError(err, "rows_affected_err")
+}
+
+func (test *BuilderTestSuite) TestUpdateVersionFieldErr() {
+	test.useDefinitions()
+
+	test.fakeFieldDefinition.On("Value").Return(reflect.ValueOf("v1")).Once()
+	test.fakeFieldDefinition.On("RecursiveFullName").Return("Version").Once()
+
+	builderInstance := Use[*models.PostsModel](test.Context, test.fakeConnector).(*builder[*models.PostsModel])
+	builderInstance.setQueryType(QueryTypeUpdate)
+
+	_, err := builderInstance.getNextVersion(test.fakeFieldDefinition)
+
+	versionErr := &VersionFieldError{}
+	test.True(errors.As(err, &versionErr))
+	test.EqualError(err, "the method `Update` requires the version field `Version` to be an integer")
+}
+
+func (test *BuilderTestSuite) TestUpdateWithFields() {
+	test.useDefinitions()
+
+	comment := &models.CommentsModel{Id: 3, Content: "test"}
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment)
+
+	var values []specs.DriverWhere
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		values = args.Get(1).(specs.Payload).Values()
+	}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	err := builderInstance.SetFields("Id", "Content").Update()
+	if !test.NoError(err) {
+		return
+	}
+
+	if !test.Len(values, 2) {
+		return
+	}
+
+	set, args, err := values[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`content` = ?", set)
+	test.Equal([]any{"test"}, args)
+
+	// The field tagged `autoUpdateTime` is refreshed even when it is not selected.
+	set, args, err = values[1].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`updated_at` = ?", set)
+	test.Equal([]any{comment.Updated}, args)
+	test.False(comment.Updated.IsZero())
+}
+
+func (test *BuilderTestSuite) TestUpdateKeepsCreationTime() {
+	test.useDefinitions()
+
+	created := time.Date(2023, 3, 24, 2, 31, 25, 0, time.UTC)
+	comment := &models.CommentsModel{Id: 3, Content: "test", Created: created}
+
+	var columns []string
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		for _, value := range args.Get(1).(specs.Payload).Values() {
+			columns = append(columns, value.From().Column())
+		}
+	}).Return(fakesql.NewResult(test.T()), nil).Twice()
+
+	// The field tagged `autoCreateTime` is only written when it is explicitly selected.
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment).Update()
+	if !test.NoError(err) {
+		return
+	}
+	test.NotContains(columns, "created_at")
+
+	columns = nil
+	err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(comment).SetFields("Created").Update()
+	if !test.NoError(err) {
+		return
+	}
+	test.Equal([]string{"created_at", "updated_at"}, columns)
+	test.Equal(created, comment.Created)
+}
+
+func (test *BuilderTestSuite) TestUpdateFieldNotWritableErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Id: 3})
+
+	err := builderInstance.SetFields("User.Email").Update()
+
+	notWritableErr := &FieldNotWritableError{}
+	test.True(errors.As(err, &notWritableErr))
+	test.EqualError(err, "the method `Update` can not write the field `User.Email`, only the columns of the model itself are writable")
+}
+
+func (test *BuilderTestSuite) TestUpdateUnknownFieldErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Id: 3})
+
+	err := builderInstance.SetFields("Unknown").Update()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestUpdateWithZeroPrimaryKeyErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{})
+
+	err := builderInstance.Update()
+
+	primaryKeyErr := &PrimaryKeyRequiredError{}
+	test.True(errors.As(err, &primaryKeyErr))
+	test.EqualError(err, "the method `Update` requires a non-zero primary key for CommentsModel")
+}
+
+func (test *BuilderTestSuite) TestUpdateWithNoPrimaryKeyErr() {
+	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector)
+	if !test.NotEmpty(builderInstance) {
+		return
+	}
+
+	test.fakeModelDefinition.On("GetPrimaryField").Return(nil, definitions.NewErrNoPrimaryField(nil)).Once()
+
+	err := builderInstance.Update()
+
+	primaryErr := &definitions.ErrPrimaryFieldNotFound{}
+	test.True(errors.As(err, &primaryErr))
+}
+
+func (test *BuilderTestSuite) TestUpdateErr() {
+	test.useDefinitions()
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetModel(&models.CommentsModel{Id: 3})
+
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(nil, errors.New("update_err")).Once()
+
+	err := builderInstance.Update()
+	test.EqualError(err, "update_err")
+}
+
+func (test *BuilderTestSuite) TestUpdateWhere() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(3), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	rowsAffected, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("user@example.com")).
+		UpdateWhere(map[string]any{"Updated": Field("Post.Updated"), "Content": "hidden"})
+	if !test.NoError(err) {
+		return
+	}
+	test.EqualValues(3, rowsAffected)
+
+	var values []string
+	var args []any
+	for _, value := range payload.Values() {
+		formatted, valueArgs, err := value.Formatted()
+		test.NoError(err)
+		values = append(values, formatted)
+		args = append(args, valueArgs...)
+	}
+	test.Equal([]string{"`t0`.`content` = ?", "`t0`.`updated_at` = `t2`.`updated_at`"}, values)
+	test.Equal([]any{"hidden"}, args)
+
+	var joins []string
+	for _, join := range payload.Join() {
+		formatted, err := join.Formatted()
+		test.NoError(err)
+		joins = append(joins, formatted)
+	}
+	test.Equal([]string{
+		"JOIN `acceptance`.`posts` AS `t2` ON `t2`.`id` = `t0`.`post_id`",
+		"JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`",
+	}, joins)
+}
+
+func (test *BuilderTestSuite) TestUpdateWhereUnconditional() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(4), nil).Once()
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(fakeResult, nil).Once()
+
+	_, err := Use[*models.UsersModel](test.Context, test.fakeConnector).UpdateWhere(map[string]any{"Validated": true})
+	test.EqualError(err, "the method `Update` requires one or more conditions, use AllowUnconditional to affect every row")
+
+	rowsAffected, err := Use[*models.UsersModel](test.Context, test.fakeConnector).AllowUnconditional().UpdateWhere(map[string]any{"Validated": true})
+	test.NoError(err)
+	test.EqualValues(4, rowsAffected)
+}
+
+func (test *BuilderTestSuite) TestUpdateWhereTimestamps() {
+	test.useDefinitions()
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(fakeResult, nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1)).
+		UpdateWhere(map[string]any{"Content": "hidden"})
+	if !test.NoError(err) {
+		return
+	}
+
+	if !test.Len(payload.Values(), 2) {
+		return
+	}
+
+	set, args, err := payload.Values()[1].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`updated_at` = ?", set)
+	if test.Len(args, 1) {
+		test.IsType(time.Time{}, args[0])
+	}
+}
+
+func (test *BuilderTestSuite) TestUpdateWhereErr() {
+	test.useDefinitions()
+
+	condition := NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1)
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetWhere(condition).UpdateWhere(map[string]any{})
+	test.EqualError(err, "the method `Update` requires the selection of one or more fields")
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetWhere(condition).UpdateWhere(map[string]any{"User.Email": "user@example.com"})
+	test.EqualError(err, "the method `Update` can not write the field `User.Email`, only the columns of the model itself are writable")
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetWhere(condition).UpdateWhere(map[string]any{"Unknown": 1})
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetWhere(condition).UpdateWhere(map[string]any{"Content": Field("Unknown")})
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+
+	test.fakeConnector.On("Update", test.Context, mock.Anything).Return(nil, errors.New("update_err")).Once()
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetWhere(condition).UpdateWhere(map[string]any{"Content": "hidden"})
+	test.EqualError(err, "update_err")
+}
+
+func (test *BuilderTestSuite) TestWhereGroups() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(Or(
+			NewCondition().SetFrom("Content").SetOperator(operators.Like).SetTo("%go%"),
+			And(
+				NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("test@test.com"),
+				Not(NewCondition().SetFrom("PostId").SetOperator(operators.In).SetTo([]int{1, 2})),
+			),
+		)).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("(`t0`.`content` LIKE ? OR (`t1`.`email` = ? AND NOT (`t0`.`post_id` IN (?))))", where)
+	test.Equal([]any{"%go%", "test@test.com", []int{1, 2}}, args)
+	test.Len(payload.Join(), 1)
+}
+
+func (test *BuilderTestSuite) TestWhereGroupsErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(Or(NewCondition().SetFrom("Unknown").SetOperator(operators.Equal).SetTo(1))).
+		FindAll()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestWhereFieldComparison() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NewCondition().SetFrom("Post.Creator.Id").SetOperator(operators.Equal).SetTo(Field("User.Id"))).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t3`.`id` = `t1`.`id`", where)
+	test.Nil(args)
+
+	var joins []string
+	for _, join := range payload.Join() {
+		formatted, err := join.Formatted()
+		test.NoError(err)
+		joins = append(joins, formatted)
+	}
+
+	test.Equal([]string{
+		"JOIN `acceptance`.`posts` AS `t2` ON `t2`.`id` = `t0`.`post_id`",
+		"JOIN `acceptance`.`users` AS `t3` ON `t3`.`id` = `t2`.`c_user_id`",
+		"JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`",
+	}, joins)
+}
+
+func (test *BuilderTestSuite) TestWhereFieldComparisonErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(Field("Unknown"))).
+		FindAll()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestWhereExists() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(Exists("Comments", Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetWhere(NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(2)))).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	index, joins, wheres, args := test.formatSubquery(payload.Where()[0])
+	test.Equal(operators.Exists, payload.Where()[0].Operator())
+	test.Equal(20, index)
+	test.Equal([]string{"JOIN `acceptance`.`users` AS `t21` ON `t21`.`id` = `t20`.`user_id`"}, joins)
+	test.Equal([]string{"`t21`.`id` = ?", "`t20`.`post_id` = `t0`.`id`"}, wheres)
+	test.Equal([]any{2}, args)
+	test.Empty(payload.Join())
+}
+
+func (test *BuilderTestSuite) TestWhereNotExistsNestedRelation() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NotExists("Post.Comments", Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetWhere(NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(1)))).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	index, subqueryJoins, wheres, args := test.formatSubquery(payload.Where()[0])
+	test.Equal(operators.NotExists, payload.Where()[0].Operator())
+	test.Equal(17, index)
+	test.Equal([]string{"JOIN `acceptance`.`users` AS `t18` ON `t18`.`id` = `t17`.`user_id`"}, subqueryJoins)
+	test.Equal([]string{"`t18`.`id` = ?", "`t17`.`post_id` = `t2`.`id`"}, wheres)
+	test.Equal([]any{1}, args)
+
+	var joins []string
+	for _, join := range payload.Join() {
+		formatted, err := join.Formatted()
+		test.NoError(err)
+		joins = append(joins, formatted)
+	}
+	test.Equal([]string{"JOIN `acceptance`.`posts` AS `t2` ON `t2`.`id` = `t0`.`post_id`"}, joins)
+}
+
+func (test *BuilderTestSuite) TestWhereInSubquery() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(3)).
+		SetWhere(NewCondition().SetFrom("PostId").SetOperator(operators.In).SetTo(Use[*models.PostsModel](test.Context, test.fakeConnector).
+			SetFields("Id").
+			SetWhere(NewCondition().SetFrom("Creator.Id").SetOperator(operators.Equal).SetTo(1)))).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Where(), 2) {
+		return
+	}
+
+	from, err := payload.Where()[1].From().Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`post_id`", from)
+	test.Equal(operators.In, payload.Where()[1].Operator())
+
+	index, joins, wheres, args := test.formatSubquery(payload.Where()[1])
+	test.Equal(17, index)
+	test.Equal([]string{"JOIN `acceptance`.`users` AS `t18` ON `t18`.`id` = `t17`.`c_user_id`"}, joins)
+	test.Equal([]string{"`t18`.`id` = ?"}, wheres)
+	test.Equal([]any{1}, args)
+}
+
+func (test *BuilderTestSuite) TestWhereExistsSubqueryRequiredErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NewCondition().SetFrom("Comments").SetOperator(operators.Exists).SetTo(1)).
+		FindAll()
+	test.ErrorContains(err, "the method `FindAll` requires a builder as the value of the operator `EXISTS`")
+}
+
+func (test *BuilderTestSuite) TestWhereExistsRelationMismatchErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(Exists("Comments", Use[*models.UsersModel](test.Context, test.fakeConnector))).
+		FindAll()
+	test.ErrorContains(err, "the method `FindAll` can not correlate the subquery on `Comments`, it is not a relation to users")
+
+	_, err = Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(Exists("Title", Use[*models.UsersModel](test.Context, test.fakeConnector))).
+		FindAll()
+	test.ErrorContains(err, "the method `FindAll` can not correlate the subquery on `Title`, it is not a relation to users")
+}
+
+func (test *BuilderTestSuite) TestWhereSubqueryErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetWhere(NewCondition().SetFrom("Id").SetOperator(operators.In).SetTo(Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetFields("Unknown"))).
+		FindAll()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestExists() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+
+		if test.Len(payload.Fields(), 1) {
+			formatted, err := payload.Fields()[0].Formatted()
+			test.NoError(err)
+			test.Equal("(1)", formatted)
+		}
+
+		limit, err := payload.Limit().Formatted()
+		test.NoError(err)
+		test.Equal("LIMIT 0, 1", limit)
+
+		test.Equal([]string{"`t1`.`email` = ?"}, test.formatWheres(payload))
+		test.Len(payload.Join(), 1)
+
+		mapping, err := payload.Mapping()
+		test.NoError(err)
+		test.NoError(payload.OnScan(mapping))
+	}).Return(nil).Once()
+
+	exists, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("test@test.com")).
+		Exists()
+	test.NoError(err)
+	test.True(exists)
+}
+
+func (test *BuilderTestSuite) TestExistsWithoutRow() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(nil).Once()
+
+	exists, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).Exists()
+	test.NoError(err)
+	test.False(exists)
+}
+
+func (test *BuilderTestSuite) TestExistsErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Unknown").SetOperator(operators.Equal).SetTo(1)).
+		Exists()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(errors.New("select_err")).Once()
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).Exists()
+	test.EqualError(err, "select_err")
+}
+
+func (test *BuilderTestSuite) TestPluck() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+
+		if test.Len(payload.Fields(), 1) {
+			formatted, err := payload.Fields()[0].Formatted()
+			test.NoError(err)
+			test.Equal("`t1`.`email`", formatted)
+		}
+		test.Len(payload.Join(), 1)
+
+		for _, email := range []string{"a@test.com", "b@test.com"} {
+			mapping, err := payload.Mapping()
+			test.NoError(err)
+			*mapping[0].(*string) = email
+			test.NoError(payload.OnScan(mapping))
+		}
+	}).Return(nil).Once()
+
+	emails, err := Pluck[string](Use[*models.CommentsModel](test.Context, test.fakeConnector), "User.Email")
+	test.NoError(err)
+	test.Equal([]string{"a@test.com", "b@test.com"}, emails)
+}
+
+func (test *BuilderTestSuite) TestPluckErr() {
+	test.useDefinitions()
+
+	_, err := Pluck[string](Use[*models.CommentsModel](test.Context, test.fakeConnector), "Unknown")
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestSetDistinct() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("User.Email").SetDistinct().FindAll()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.True(payload.Distinct())
+	test.Empty(payload.Compounds())
+}
+
+func (test *BuilderTestSuite) TestUnion() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id", "User.Email").
+		SetWhere(NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(1)).
+		UnionAll(Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetWhere(NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(2))).
+		Except(Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetWhere(NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(3))).
+		SetOrderBy("-Id").
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Compounds(), 2) {
+		return
+	}
+
+	test.Equal("UNION ALL", payload.Compounds()[0].Operator())
+	test.Equal("EXCEPT", payload.Compounds()[1].Operator())
+
+	// The combined builders select the fields of the builder, with their own aliases and joins.
+	for i, joins := range []int{2, 1} {
+		compound := payload.Compounds()[i].Payload()
+
+		var fields []string
+		for _, field := range compound.Fields() {
+			fields = append(fields, field.Name())
+		}
+		test.Equal([]string{"Id", "User.Email"}, fields)
+		test.Len(compound.Join(), joins)
+		test.Greater(compound.Index(), 0)
+	}
+}
+
+func (test *BuilderTestSuite) TestUnionWithFields() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		Union(Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Parent.Id")).
+		Intersect(Use[*models.CommentsModel](test.Context, test.fakeConnector)).
+		FindAll()
+	if !test.NoError(err) || !test.Len(payload.Compounds(), 2) {
+		return
+	}
+
+	test.Equal("UNION", payload.Compounds()[0].Operator())
+	test.Equal("Parent.Id", payload.Compounds()[0].Payload().Fields()[0].Name())
+	test.Equal("INTERSECT", payload.Compounds()[1].Operator())
+}
+
+func (test *BuilderTestSuite) TestUnionErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		Union(Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetWhere(NewCondition().SetFrom("Unknown").SetOperator(operators.Equal).SetTo(1))).
+		FindAll()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+type commentSummary struct {
+	Id          uint
+	AuthorEmail string `dbKit:"from:User.Email"`
+	PostTitle   string `dbKit:"from:Post.Title"`
+}
+
+func (test *BuilderTestSuite) TestFindAllIntoStruct() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+
+		var fields []string
+		for _, field := range payload.Fields() {
+			formatted, err := field.Formatted()
+			test.NoError(err)
+			fields = append(fields, formatted)
+		}
+		test.Equal([]string{"`t0`.`id`", "`t1`.`email`", "`t2`.`title`"}, fields)
+		test.Len(payload.Join(), 2)
+
+		mapping, err := payload.Mapping()
+		test.NoError(err)
+		*mapping[0].(*uint) = 1
+		*mapping[1].(*string) = "test@test.com"
+		*mapping[2].(*string) = "title"
+		test.NoError(payload.OnScan(mapping))
+	}).Return(nil).Once()
+
+	result, err := FindAllInto[commentSummary](Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Content"))
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal([]commentSummary{{Id: 1, AuthorEmail: "test@test.com", PostTitle: "title"}}, result)
+}
+
+func (test *BuilderTestSuite) TestFindAllIntoStructErr() {
+	test.useDefinitions()
+
+	_, err := FindAllInto[commentSummary](Use[*models.UsersModel](test.Context, test.fakeConnector))
+	test.ErrorContains(err, "field `User.Email` not found in model `UsersModel`")
+}
+
+func (test *BuilderTestSuite) TestAggregate() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+
+		var fields []string
+		for _, field := range payload.Fields() {
+			formatted, err := field.Formatted()
+			test.NoError(err)
+			fields = append(fields, formatted)
+		}
+		test.Equal([]string{"`t1`.`id`", "(COUNT(`t0`.`id`))", "(MAX(`t0`.`created_at`))", "`t1`.`email`"}, fields)
+
+		if test.Len(payload.GroupBy(), 1) {
+			group, err := payload.GroupBy()[0].Formatted()
+			test.NoError(err)
+			test.Equal("`t1`.`id`", group)
+		}
+
+		if test.Len(payload.Having(), 1) {
+			having, args, err := payload.Having()[0].Formatted()
+			test.NoError(err)
+			test.Equal("(COUNT(`t0`.`id`)) > ?", having)
+			test.Equal([]any{1}, args)
+		}
+
+		if test.Len(payload.OrderBy(), 1) {
+			order, err := payload.OrderBy()[0].Formatted()
+			test.NoError(err)
+			test.Equal("(COUNT(`t0`.`id`)) DESC", order)
+		}
+
+		test.Len(payload.Join(), 1)
+
+		mapping, err := payload.Mapping()
+		test.NoError(err)
+		*mapping[0].(*uint) = 2
+		*mapping[1].(*int64) = 3
+		test.NoError(payload.OnScan(mapping))
+	}).Return(nil).Once()
+
+	result, err := Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetGroupBy("User.Id").
+			SetHaving(NewCondition().SetFrom("Total").SetOperator(operators.Greater).SetTo(1)).
+			SetOrderBy("-Total"),
+		Count("Id").As("Total"),
+		Max("Created").As("LastCreated"),
+	)
+	if !test.NoError(err) || !test.Len(result, 1) {
+		return
+	}
+
+	test.EqualValues(2, result[0].UserId)
+	test.EqualValues(3, result[0].Total)
+}
+
+func (test *BuilderTestSuite) TestAggregateWhereField() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	// The count is read under its default alias, the path of the field it counts.
+	type idsPerUser struct {
+		UserId uint `dbKit:"from:User.Id"`
+		Id     int64
+	}
+
+	_, err := Aggregate[idsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetGroupBy("User.Id").
+			SetWhere(NewCondition().SetFrom("Id").SetOperator(operators.Greater).SetTo(5)),
+		Count("Id"),
+	)
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`id` > ?", where)
+	test.Equal([]any{5}, args)
+}
+
+func (test *BuilderTestSuite) TestAggregateFunctions() {
+	for function, aggregate := range map[string]specs.Aggregate{
+		"COUNT": Count("Id"),
+		"SUM":   Sum("Id"),
+		"AVG":   Avg("Id"),
+		"MIN":   Min("Id"),
+		"MAX":   Max("Id"),
+	} {
+		test.Equal(function, aggregate.Function())
+		test.Equal("Id", aggregate.From())
+		test.Equal("Id", aggregate.Alias())
+	}
+}
+
+func (test *BuilderTestSuite) TestAggregateUnknownFieldErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[commentsPerUser](Use[*models.CommentsModel](test.Context, test.fakeConnector), Count("Unknown").As("Total"))
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestAggregateFieldNotSelectableErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[commentsPerUser](Use[*models.CommentsModel](test.Context, test.fakeConnector), Count("Post.Comments.Id").As("Total"))
+
+	notSelectableErr := &FieldNotSelectableError{}
+	test.True(errors.As(err, &notSelectableErr))
+	test.EqualError(err, "the method `FindAllInto` can not select the field `Post.Comments.Id`, it belongs to a slice relation")
+}
+
+func (test *BuilderTestSuite) TestAggregateGroupByErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).SetGroupBy("Post.Comments.Id"),
+		Count("Id").As("Total"),
+		Max("Created").As("LastCreated"),
+	)
+	test.ErrorContains(err, "can not select the field `Post.Comments.Id`")
+
+	_, err = Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).SetGroupBy("Unknown"),
+		Count("Id").As("Total"),
+		Max("Created").As("LastCreated"),
+	)
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestAggregateHavingErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).
+			SetHaving(NewCondition().SetFrom("Total").SetOperator(operators.Greater).SetTo(1)),
+		Count("Unknown").As("Total"),
+	)
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestAggregateOrderByErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector).SetOrderBy("Total"),
+		Count("Unknown").As("Total"),
+	)
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestAggregateWithoutFieldErr() {
+	test.useDefinitions()
+
+	_, err := Aggregate[int](Use[*models.CommentsModel](test.Context, test.fakeConnector))
+	test.EqualError(err, "the method `FindAllInto` requires the selection of one or more fields")
+}
+
+func (test *BuilderTestSuite) TestAggregateSelectErr() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(errors.New("select_err")).Once()
+
+	_, err := Aggregate[commentsPerUser](
+		Use[*models.CommentsModel](test.Context, test.fakeConnector),
+		Count("Id").As("Total"),
+		Max("Created").As("LastCreated"),
+	)
+	test.EqualError(err, "select_err")
+}
+
+func (test *BuilderTestSuite) TestLimit() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetLimit(10).SetOffset(20).FindAll()
+	if !test.NoError(err) || !test.NotNil(payload.Limit()) {
+		return
+	}
+
+	limit, err := payload.Limit().Formatted()
+	test.NoError(err)
+	test.Equal("LIMIT 20, 10", limit)
+}
+
+func (test *BuilderTestSuite) TestOffset() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOffset(5).FindAll()
+	if !test.NoError(err) || !test.NotNil(payload.Limit()) {
+		return
+	}
+
+	test.Equal(0, payload.Limit().Limit())
+	test.Equal(5, payload.Limit().Offset())
+}
+
+func (test *BuilderTestSuite) TestWithoutLimit() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindAll()
+	test.NoError(err)
+	test.Nil(payload.Limit())
+}
+
+func (test *BuilderTestSuite) TestFindLimit() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOffset(3).Find()
+	test.ErrorContains(err, "empty result for CommentsModel")
+
+	if !test.NotNil(payload.Limit()) {
+		return
+	}
+
+	limit, err := payload.Limit().Formatted()
+	test.NoError(err)
+	test.Equal("LIMIT 3, 1", limit)
+}
+
+func (test *BuilderTestSuite) TestOrderBy() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("-Created", "User.Email").FindAll()
+	if !test.NoError(err) || !test.Len(payload.OrderBy(), 2) || !test.Len(payload.Join(), 1) {
+		return
+	}
+
+	var orders []string
+	for _, order := range payload.OrderBy() {
+		formatted, err := order.Formatted()
+		test.NoError(err)
+		orders = append(orders, formatted)
+	}
+	test.Equal([]string{"`t0`.`created_at` DESC", "`t1`.`email` ASC"}, orders)
+
+	join, err := payload.Join()[0].Formatted()
+	test.NoError(err)
+	test.Equal("JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", join)
+}
+
+func (test *BuilderTestSuite) TestOrderByUnknownFieldErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("Unknown").FindAll()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestOrderByFieldNotSortableErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("Post.Comments.Id").FindAll()
+
+	notSortableErr := &FieldNotSortableError{}
+	test.True(errors.As(err, &notSortableErr))
+	test.EqualError(err, "the method `FindAll` can not sort by the field `Post.Comments.Id`, it belongs to a slice relation")
+}
+
+// scanPage fakes a select returning the comments with the given ids, in the order of the query.
+func (test *BuilderTestSuite) scanPage(wheres *[]string, orders *[]string, ids ...uint) {
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.Payload)
+
+		for _, where := range payload.Where() {
+			formatted, _, err := where.Formatted()
+			test.NoError(err)
+			*wheres = append(*wheres, formatted)
+		}
+
+		for _, order := range payload.OrderBy() {
+			formatted, err := order.Formatted()
+			test.NoError(err)
+			*orders = append(*orders, formatted)
+		}
+
+		for _, id := range ids {
+			mapping, err := payload.Mapping()
+			test.NoError(err)
+			*mapping[0].(*uint) = id
+			test.NoError(payload.OnScan(mapping))
+		}
+	}).Return(nil).Once()
+}
+
+func (test *BuilderTestSuite) TestFindPage() {
+	test.useDefinitions()
+
+	var wheres, orders []string
+	test.scanPage(&wheres, &orders, 1, 2, 3)
+
+	page, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindPage("", 2)
+	if !test.NoError(err) || !test.Len(page.Items(), 2) {
+		return
+	}
+	test.EqualValues(1, page.Items()[0].Id)
+	test.EqualValues(2, page.Items()[1].Id)
+	test.NotEmpty(page.Next())
+	test.Empty(page.Previous())
+	test.Empty(wheres)
+	test.Equal([]string{"`t0`.`id` ASC"}, orders)
+
+	wheres, orders = nil, nil
+	test.scanPage(&wheres, &orders, 3)
+
+	page, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindPage(page.Next(), 2)
+	if !test.NoError(err) || !test.Len(page.Items(), 1) {
+		return
+	}
+	test.EqualValues(3, page.Items()[0].Id)
+	test.Empty(page.Next())
+	test.NotEmpty(page.Previous())
+	test.Equal([]string{"((`t0`.`id` > ?))"}, wheres)
+	test.Equal([]string{"`t0`.`id` ASC"}, orders)
+
+	wheres, orders = nil, nil
+	test.scanPage(&wheres, &orders, 2, 1)
+
+	page, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindPage(page.Previous(), 2)
+	if !test.NoError(err) || !test.Len(page.Items(), 2) {
+		return
+	}
+	test.EqualValues(1, page.Items()[0].Id)
+	test.EqualValues(2, page.Items()[1].Id)
+	test.NotEmpty(page.Next())
+	test.Empty(page.Previous())
+	test.Equal([]string{"((`t0`.`id` < ?))"}, wheres)
+	test.Equal([]string{"`t0`.`id` DESC"}, orders)
+}
+
+func (test *BuilderTestSuite) TestFindPageWithOrderBy() {
+	test.useDefinitions()
+
+	cursor, err := encodeCursor([]specs.FieldDefinition{
+		test.getField(&models.CommentsModel{User: models.UsersModel{Email: "user@example.com"}}, "User.Email"),
+		test.getField(&models.CommentsModel{Id: 4}, "Id"),
+	}, false)
+	if !test.NoError(err) {
+		return
+	}
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Once()
+
+	page, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetOrderBy("-User.Email").
+		FindPage(cursor, 10)
+	if !test.NoError(err) || !test.Len(payload.Where(), 1) {
+		return
+	}
+	test.Empty(page.Items())
+
+	where, args, err := payload.Where()[0].Formatted()
+	test.NoError(err)
+	test.Equal("((`t1`.`email` < ?) OR (`t1`.`email` = ? AND `t0`.`id` > ?))", where)
+	test.Equal([]any{"user@example.com", "user@example.com", uint(4)}, args)
+
+	var fields []string
+	for _, field := range payload.Fields() {
+		fields = append(fields, field.Name())
+	}
+	test.Equal([]string{"Id", "User.Email"}, fields)
+
+	if test.Len(payload.Join(), 1) {
+		join, err := payload.Join()[0].Formatted()
+		test.NoError(err)
+		test.Equal("JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", join)
+	}
+
+	limit, err := payload.Limit().Formatted()
+	test.NoError(err)
+	test.Equal("LIMIT 0, 11", limit)
+}
+
+func (test *BuilderTestSuite) TestFindPageKeepsBuilder() {
+	test.useDefinitions()
+
+	cursor, err := encodeCursor([]specs.FieldDefinition{
+		test.getField(&models.CommentsModel{User: models.UsersModel{Email: "user@example.com"}}, "User.Email"),
+		test.getField(&models.CommentsModel{Id: 4}, "Id"),
+	}, true)
+	if !test.NoError(err) {
+		return
+	}
+
+	var payload specs.Payload
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(nil).Twice()
+
+	current := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id").
+		SetOrderBy("-User.Email")
+
+	_, err = current.FindPage("", 10)
+	if !test.NoError(err) {
+		return
+	}
+
+	_, err = current.FindPage(cursor, 10)
+	if !test.NoError(err) {
+		return
+	}
+
+	var wheres []string
+	for _, where := range payload.Where() {
+		formatted, _, err := where.Formatted()
+		test.NoError(err)
+		wheres = append(wheres, formatted)
+	}
+	test.Equal([]string{"((`t1`.`email` > ?) OR (`t1`.`email` = ? AND `t0`.`id` < ?))"}, wheres)
+
+	var orders []string
+	for _, order := range payload.OrderBy() {
+		formatted, err := order.Formatted()
+		test.NoError(err)
+		orders = append(orders, formatted)
+	}
+	test.Equal([]string{"`t1`.`email` ASC", "`t0`.`id` DESC"}, orders)
+
+	var fields []string
+	for _, field := range payload.Fields() {
+		fields = append(fields, field.Name())
+	}
+	test.Equal([]string{"Id", "User.Email"}, fields)
+	test.Len(payload.Join(), 1)
+
+	limit, err := payload.Limit().Formatted()
+	test.NoError(err)
+	test.Equal("LIMIT 0, 11", limit)
+
+	test.Equal([]string{"Id"}, current.(*builder[*models.CommentsModel]).fields)
+	test.Equal([]string{"-User.Email"}, current.(*builder[*models.CommentsModel]).orders)
+	test.Empty(current.(*builder[*models.CommentsModel]).wheres)
+	test.Nil(current.(*builder[*models.CommentsModel]).driverLimit)
+}
+
+func (test *BuilderTestSuite) getField(model specs.Model, path string) specs.FieldDefinition {
+	field, err := definitions.Use(model).Parse().GetFieldByName(path)
+	test.NoError(err)
+	return field
+}
+
+func (test *BuilderTestSuite) TestFindPageErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindPage("", 0)
+	test.EqualError(err, "the method `FindPage` requires a page size of at least 1, got 0")
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindPage("invalid", 10)
+	test.EqualError(err, "the cursor `invalid` is invalid for this query")
+
+	cursor, err := encodeCursor([]specs.FieldDefinition{test.getField(&models.CommentsModel{Id: 4}, "Id")}, false)
+	test.NoError(err)
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("Created").FindPage(cursor, 10)
+	test.EqualError(err, fmt.Sprintf("the cursor `%s` is invalid for this query", cursor))
+
+	_, err = Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("Unknown").FindPage("", 10)
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+
+	_, err = Use[*models.UsersModel](test.Context, test.fakeConnector).SetFields("Id").SetOrderBy("CreatedAt").FindPage("", 10)
+	test.EqualError(err, "the method `FindPage` can not paginate on the field `CreatedAt`, it is nullable")
+}
+
+// scanEach fakes a select handing the comments with the given ids to the payload, stopping at the first error like the drivers.
+func (test *BuilderTestSuite) scanEach(ids ...uint) {
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(func(ctx context.Context, payload specs.Payload) error {
+		for _, id := range ids {
+			mapping, err := payload.Mapping()
+			if err != nil {
+				return err
+			}
+
+			*mapping[0].(*uint) = id
+			if err := payload.OnScan(mapping); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Once()
+}
+
+func (test *BuilderTestSuite) TestFindEach() {
+	test.useDefinitions()
+	test.scanEach(1, 2, 3)
+
+	builderInstance := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id")
+
+	var ids []uint
+	err := builderInstance.FindEach(func(comment *models.CommentsModel) error {
+		ids = append(ids, comment.Id)
+		return nil
+	})
+	test.NoError(err)
+	test.Equal([]uint{1, 2, 3}, ids)
+	test.Empty(builderInstance.Payload().Result())
+}
+
+func (test *BuilderTestSuite) TestFindEachStop() {
+	test.useDefinitions()
+	test.scanEach(1, 2, 3)
+
+	var ids []uint
+	err := Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindEach(func(comment *models.CommentsModel) error {
+		ids = append(ids, comment.Id)
+		if comment.Id == 2 {
+			return errors.New("stop")
+		}
+		return nil
+	})
+	test.EqualError(err, "stop")
+	test.Equal([]uint{1, 2}, ids)
+}
+
+func (test *BuilderTestSuite) TestFindEachErr() {
+	test.useDefinitions()
+
+	err := Use[*models.PostsModel](test.Context, test.fakeConnector).SetFields("Id", "Comments.Id").FindEach(func(post *models.PostsModel) error {
+		return nil
+	})
+	test.ErrorContains(err, "the method `FindEach` can not select the field `Comments.Id`")
+
+	err = Use[*models.PostsModel](test.Context, test.fakeConnector).SetFields("Unknown").FindEach(func(post *models.PostsModel) error {
+		return nil
+	})
+	test.ErrorContains(err, "field `Unknown` not found in model `PostsModel`")
+
+	err = Use[*models.PostsModel](test.Context, test.fakeConnector).FindEach(func(post *models.PostsModel) error {
+		return nil
+	})
+	test.EqualError(err, "the method `FindEach` requires the selection of one or more fields")
+}
+
+func (test *BuilderTestSuite) TestFindSeq() {
+	test.useDefinitions()
+	test.scanEach(1, 2, 3)
+
+	var ids []uint
+	for comment, err := range Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindSeq() {
+		if !test.NoError(err) {
+			return
+		}
+
+		ids = append(ids, comment.Id)
+		if comment.Id == 2 {
+			break
+		}
+	}
+	test.Equal([]uint{1, 2}, ids)
+}
+
+func (test *BuilderTestSuite) TestFindSeqErr() {
+	test.useDefinitions()
+	test.fakeConnector.On("Select", test.Context, mock.Anything).Return(errors.New("select_err")).Once()
+
+	var errs []error
+	for comment, err := range Use[*models.CommentsModel](test.Context, test.fakeConnector).SetFields("Id").FindSeq() {
+		test.Nil(comment)
+		errs = append(errs, err)
+	}
+	if test.Len(errs, 1) {
+		test.EqualError(errs[0], "select_err")
+	}
+}
+
+func (test *BuilderTestSuite) TestCount() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Count", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(int64(3), nil).Once()
+
+	total, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetFields("Id", "Post.Title").
+		SetWhere(NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("test@test.com")).
+		Count()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.EqualValues(3, total)
+	test.Empty(payload.Fields())
+
+	if !test.Len(payload.Join(), 1) {
+		return
+	}
+
+	join, err := payload.Join()[0].Formatted()
+	test.NoError(err)
+	test.Equal("JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", join)
+}
+
+func (test *BuilderTestSuite) TestCountSliceRelation() {
+	test.useDefinitions()
+
+	var payload specs.Payload
+	test.fakeConnector.On("Count", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload = args.Get(1).(specs.Payload)
+	}).Return(int64(2), nil).Once()
+
+	total, err := Use[*models.PostsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Comments.Content").SetOperator(operators.Equal).SetTo("test")).
+		Count()
+	if !test.NoError(err) {
+		return
+	}
+
+	test.EqualValues(2, total)
+
+	// The posts repeated for each of their comments are counted once, by their primary key.
+	if !test.Len(payload.Fields(), 1) {
+		return
+	}
+
+	field, err := payload.Fields()[0].Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`id`", field)
+	test.Len(payload.Join(), 1)
+}
+
+func (test *BuilderTestSuite) TestCountClauseNotSupportedErr() {
+	test.useDefinitions()
+
+	for clause, builderInstance := range map[string]specs.Builder[*models.CommentsModel]{
+		"GROUP BY":  Use[*models.CommentsModel](test.Context, test.fakeConnector).SetGroupBy("PostId"),
+		"HAVING":    Use[*models.CommentsModel](test.Context, test.fakeConnector).SetHaving(NewCondition().SetFrom("Id").SetOperator(operators.Greater).SetTo(1)),
+		"DISTINCT":  Use[*models.CommentsModel](test.Context, test.fakeConnector).SetDistinct(),
+		"UNION ALL": Use[*models.CommentsModel](test.Context, test.fakeConnector).UnionAll(Use[*models.CommentsModel](test.Context, test.fakeConnector)),
+	} {
+		_, err := builderInstance.Count()
+
+		clauseErr := &ClauseNotSupportedError{}
+		test.True(errors.As(err, &clauseErr), clause)
+		test.EqualError(err, fmt.Sprintf("the method `Count` does not support the `%s` clause", clause))
+	}
+}
+
+func (test *BuilderTestSuite) TestCountBuildWhereErr() {
+	test.useDefinitions()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).
+		SetWhere(NewCondition().SetFrom("Unknown").SetOperator(operators.Equal).SetTo(1)).
+		Count()
+	test.ErrorContains(err, "field `Unknown` not found in model `CommentsModel`")
+}
+
+func (test *BuilderTestSuite) TestCountErr() {
+	test.useDefinitions()
+
+	test.fakeConnector.On("Count", test.Context, mock.Anything).Return(int64(0), errors.New("count_err")).Once()
+
+	_, err := Use[*models.CommentsModel](test.Context, test.fakeConnector).Count()
+	test.EqualError(err, "count_err")
 }
 
 func TestBuilderTestSuite(t *testing.T) {
@@ -1,11 +1,15 @@
 package dbkit
 
-import "github.com/kitstack/dbkit/specs"
+import (
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/specs"
+)
 
 type condition struct {
-	from     string
-	operator string
-	to       any
+	from       string
+	operator   string
+	to         any
+	conditions []specs.Condition
 }
 
 func (w *condition) From() string {
@@ -20,6 +24,19 @@ func (w *condition) To() any {
 	return w.to
 }
 
+func (w *condition) Conditions() []specs.Condition {
+	return w.conditions
+}
+
+// IsGroup reports whether the condition combines nested conditions (AND, OR, NOT) instead of comparing a field.
+func (w *condition) IsGroup() bool {
+	switch w.operator {
+	case operators.And, operators.Or, operators.Not:
+		return true
+	}
+	return false
+}
+
 func (w *condition) SetFrom(from string) specs.Condition {
 	w.from = from
 	return w
@@ -35,6 +52,41 @@ func (w *condition) SetTo(to any) specs.Condition {
 	return w
 }
 
+func (w *condition) SetConditions(conditions ...specs.Condition) specs.Condition {
+	w.conditions = conditions
+	return w
+}
+
 func NewCondition() specs.Condition {
 	return new(condition)
 }
+
+// Field references a model field (e.g. `User.Id`) to compare it with the field of a condition instead of a value.
+func Field(path string) specs.FieldPath {
+	return specs.FieldPath(path)
+}
+
+// And matches when every condition matches.
+func And(conditions ...specs.Condition) specs.Condition {
+	return NewCondition().SetOperator(operators.And).SetConditions(conditions...)
+}
+
+// Or matches when at least one of the conditions matches.
+func Or(conditions ...specs.Condition) specs.Condition {
+	return NewCondition().SetOperator(operators.Or).SetConditions(conditions...)
+}
+
+// Not matches when the conditions, combined with AND, do not match.
+func Not(conditions ...specs.Condition) specs.Condition {
+	return NewCondition().SetOperator(operators.Not).SetConditions(conditions...)
+}
+
+// Exists matches when the subquery returns a row, it is correlated on the relation path (e.g. `Comments`) unless the path is empty.
+func Exists(relation string, subquery specs.Subquery) specs.Condition {
+	return NewCondition().SetFrom(relation).SetOperator(operators.Exists).SetTo(subquery)
+}
+
+// NotExists matches when the subquery returns no row, it is correlated like Exists.
+func NotExists(relation string, subquery specs.Subquery) specs.Condition {
+	return NewCondition().SetFrom(relation).SetOperator(operators.NotExists).SetTo(subquery)
+}
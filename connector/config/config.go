@@ -14,6 +14,8 @@ type config struct {
 	port     int
 	database string
 	locale   string
+
+	maxAllowedPacket int
 }
 
 func (c *config) Name() string {
@@ -94,6 +96,19 @@ func (c *config) SetLocale(locale string) specs.Config {
 	return c
 }
 
+// MaxAllowedPacket returns the max_allowed_packet of the server, 64MB by default as in MySQL 8.
+func (c *config) MaxAllowedPacket() int {
+	if c.maxAllowedPacket == 0 {
+		return 64 << 20
+	}
+	return c.maxAllowedPacket
+}
+
+func (c *config) SetMaxAllowedPacket(size int) specs.Config {
+	c.maxAllowedPacket = size
+	return c
+}
+
 func New() specs.Config {
 	return new(config)
 }
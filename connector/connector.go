@@ -1,6 +1,10 @@
 package connector
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/kitstack/dbkit/connector/drivers"
 	"github.com/kitstack/dbkit/specs"
 )
@@ -35,6 +39,72 @@ func (c *connector) create() (err error) {
 	return c.New(c.Config())
 }
 
+// Transaction runs fn in a transaction opened on the pool of the connector, it is committed when fn returns nil and
+// rolled back when it returns an error or panics, the panic is then propagated. Within a transaction already carried
+// by the context, fn runs in a savepoint instead.
+func (c *connector) Transaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if tx, ok := drivers.TxFromContext(ctx, c.Get()); ok {
+		return c.savepoint(ctx, tx, fn)
+	}
+
+	tx, err := c.Get().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	err = fn(drivers.WithTx(ctx, c.Get(), tx))
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// savepoint runs fn in a savepoint of the transaction, only the statements of fn are rolled back when it returns an
+// error or panics, the savepoint is released otherwise and its statements are committed with the transaction.
+func (c *connector) savepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) (err error) {
+	ctx, name, _ := drivers.WithSavepoint(ctx, c.Get())
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SAVEPOINT `%s`", name))
+	if err != nil {
+		return err
+	}
+
+	rollback := func() error {
+		_, err := tx.ExecContext(ctx, fmt.Sprintf("ROLLBACK TO SAVEPOINT `%s`", name))
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = rollback()
+			panic(r)
+		}
+	}()
+
+	err = fn(ctx)
+	if err != nil {
+		if rollbackErr := rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("RELEASE SAVEPOINT `%s`", name))
+
+	return err
+}
+
 func (c *connector) Config() specs.Config {
 	return c.config
 }
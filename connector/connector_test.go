@@ -3,6 +3,8 @@ package connector
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"github.com/kitstack/dbkit/connector/config"
 	"github.com/kitstack/dbkit/connector/drivers"
 	"github.com/kitstack/dbkit/specs"
@@ -18,11 +20,12 @@ type ConnectorTestSuite struct {
 }
 
 func (test *ConnectorTestSuite) SetupTest() {
-	test.fakeDriver = fakesql.NewDriver(test.T())
 	test.Context = context.Background()
 }
 
 func (test *ConnectorTestSuite) SetupSuite() {
+	test.fakeDriver = fakesql.NewDriver(test.T())
+
 	drivers.RegisteredDriver = map[string]func() specs.Driver{
 		"test": func() specs.Driver {
 			return new(drivers.Mysql)
@@ -48,6 +51,166 @@ func (test *ConnectorTestSuite) TestFailNewConnector() {
 	test.Empty(conn)
 }
 
+func (test *ConnectorTestSuite) newTransactionConnector() (specs.Connector, *fakesql.FakeConn, *fakesql.Tx) {
+	conn, err := New("test", config.New().SetDriver("test"))
+	test.NoError(err)
+
+	fakeConn := fakesql.NewFakeConn(test.T())
+	fakeTx := fakesql.NewTx(test.T())
+	test.fakeDriver.On("Open", ":@tcp(:3306)/?parseTime=true&loc=Local").Return(fakeConn, nil).Once()
+	fakeConn.On("Begin").Return(fakeTx, nil).Once()
+	fakeConn.On("Close").Return(nil).Maybe()
+
+	return conn, fakeConn, fakeTx
+}
+
+// expectStatement expects the statement to be executed once on the connection, with the given error.
+func (test *ConnectorTestSuite) expectStatement(fakeConn *fakesql.FakeConn, query string, err error) {
+	fakeStmt := fakesql.NewFakeStmt(test.T())
+	fakeConn.On("Prepare", query).Return(fakeStmt, nil).Once()
+	fakeStmt.On("NumInput").Return(0)
+	fakeStmt.On("Close").Return(nil)
+	fakeStmt.On("Exec", []driver.Value{}).Return(driver.ResultNoRows, err).Once()
+}
+
+func (test *ConnectorTestSuite) TestTransactionCommit() {
+	conn, _, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Commit").Return(nil).Once()
+
+	err := conn.Transaction(test.Context, func(ctx context.Context) error {
+		tx, ok := drivers.TxFromContext(ctx, conn.Get())
+		test.True(ok)
+		test.NotNil(tx)
+		return nil
+	})
+	test.NoError(err)
+}
+
+func (test *ConnectorTestSuite) TestTransactionSavepoint() {
+	conn, fakeConn, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Commit").Return(nil).Once()
+
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_1`", nil)
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_2`", nil)
+	test.expectStatement(fakeConn, "ROLLBACK TO SAVEPOINT `sp_2`", nil)
+	test.expectStatement(fakeConn, "RELEASE SAVEPOINT `sp_1`", nil)
+
+	err := conn.Transaction(test.Context, func(ctx context.Context) error {
+		tx, _ := drivers.TxFromContext(ctx, conn.Get())
+
+		return conn.Transaction(ctx, func(ctx context.Context) error {
+			nestedTx, _ := drivers.TxFromContext(ctx, conn.Get())
+			test.Same(tx, nestedTx)
+
+			// Only the innermost savepoint is rolled back.
+			err := conn.Transaction(ctx, func(ctx context.Context) error {
+				return errors.New("nested_err")
+			})
+			test.EqualError(err, "nested_err")
+
+			return nil
+		})
+	})
+	test.NoError(err)
+}
+
+func (test *ConnectorTestSuite) TestTransactionSavepointPanic() {
+	conn, fakeConn, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Rollback").Return(nil).Once()
+
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_1`", nil)
+	test.expectStatement(fakeConn, "ROLLBACK TO SAVEPOINT `sp_1`", nil)
+
+	test.PanicsWithValue("fn_panic", func() {
+		_ = conn.Transaction(test.Context, func(ctx context.Context) error {
+			return conn.Transaction(ctx, func(ctx context.Context) error {
+				panic("fn_panic")
+			})
+		})
+	})
+}
+
+func (test *ConnectorTestSuite) TestTransactionSavepointErr() {
+	conn, fakeConn, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Rollback").Return(nil).Once()
+
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_1`", errors.New("savepoint_err"))
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_1`", nil)
+	test.expectStatement(fakeConn, "ROLLBACK TO SAVEPOINT `sp_1`", errors.New("rollback_err"))
+	test.expectStatement(fakeConn, "SAVEPOINT `sp_1`", nil)
+	test.expectStatement(fakeConn, "RELEASE SAVEPOINT `sp_1`", errors.New("release_err"))
+
+	err := conn.Transaction(test.Context, func(ctx context.Context) error {
+		err := conn.Transaction(ctx, func(ctx context.Context) error {
+			test.Fail("fn must not be called")
+			return nil
+		})
+		test.EqualError(err, "savepoint_err")
+
+		err = conn.Transaction(ctx, func(ctx context.Context) error {
+			return errors.New("fn_err")
+		})
+		test.EqualError(err, "fn_err\nrollback_err")
+
+		err = conn.Transaction(ctx, func(ctx context.Context) error {
+			return nil
+		})
+		test.EqualError(err, "release_err")
+
+		return err
+	})
+	test.EqualError(err, "release_err")
+}
+
+func (test *ConnectorTestSuite) TestTransactionRollback() {
+	conn, _, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Rollback").Return(nil).Once()
+
+	err := conn.Transaction(test.Context, func(ctx context.Context) error {
+		return errors.New("fn_err")
+	})
+	test.EqualError(err, "fn_err")
+}
+
+func (test *ConnectorTestSuite) TestTransactionRollbackErr() {
+	conn, _, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Rollback").Return(errors.New("rollback_err")).Once()
+
+	err := conn.Transaction(test.Context, func(ctx context.Context) error {
+		return errors.New("fn_err")
+	})
+	test.EqualError(err, "fn_err\nrollback_err")
+}
+
+func (test *ConnectorTestSuite) TestTransactionPanic() {
+	conn, _, fakeTx := test.newTransactionConnector()
+	fakeTx.On("Rollback").Return(nil).Once()
+
+	test.PanicsWithValue("fn_panic", func() {
+		_ = conn.Transaction(test.Context, func(ctx context.Context) error {
+			panic("fn_panic")
+		})
+	})
+}
+
+func (test *ConnectorTestSuite) TestTransactionBeginErr() {
+	conn, err := New("test", config.New().SetDriver("test"))
+	if !test.NoError(err) {
+		return
+	}
+
+	fakeConn := fakesql.NewFakeConn(test.T())
+	test.fakeDriver.On("Open", ":@tcp(:3306)/?parseTime=true&loc=Local").Return(fakeConn, nil).Once()
+	fakeConn.On("Begin").Return(nil, errors.New("begin_err")).Once()
+	fakeConn.On("Close").Return(nil).Maybe()
+
+	err = conn.Transaction(test.Context, func(ctx context.Context) error {
+		test.Fail("fn must not be called")
+		return nil
+	})
+	test.EqualError(err, "begin_err")
+}
+
 func TestSchemaTestSuite(t *testing.T) {
 	suite.Run(t, new(ConnectorTestSuite))
 }
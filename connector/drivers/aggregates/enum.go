@@ -0,0 +1,9 @@
+package aggregates
+
+var (
+	Count = "COUNT"
+	Sum   = "SUM"
+	Avg   = "AVG"
+	Min   = "MIN"
+	Max   = "MAX"
+)
@@ -0,0 +1,33 @@
+package drivers
+
+import (
+	"github.com/kitstack/dbkit/connector/drivers/compounds"
+	"github.com/kitstack/dbkit/specs"
+)
+
+type compound struct {
+	operator specs.CompoundOperator
+	payload  specs.Payload
+}
+
+func (c *compound) Operator() string {
+	return compounds.Operator[c.operator]
+}
+
+func (c *compound) Payload() specs.Payload {
+	return c.payload
+}
+
+func (c *compound) SetOperator(operator specs.CompoundOperator) specs.DriverCompound {
+	c.operator = operator
+	return c
+}
+
+func (c *compound) SetPayload(payload specs.Payload) specs.DriverCompound {
+	c.payload = payload
+	return c
+}
+
+func NewCompound() specs.DriverCompound {
+	return new(compound)
+}
@@ -0,0 +1,35 @@
+package drivers
+
+import (
+	"github.com/kitstack/dbkit/connector/drivers/compounds"
+	"github.com/kitstack/dbkit/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type CompoundTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CompoundTestSuite) TestCompound() {
+	compound := NewCompound()
+	suite.Equal("UNION", compound.Operator())
+	suite.Nil(compound.Payload())
+
+	compound.SetOperator(compounds.UnionAll)
+	suite.Equal("UNION ALL", compound.Operator())
+
+	compound.SetOperator(compounds.Intersect)
+	suite.Equal("INTERSECT", compound.Operator())
+
+	compound.SetOperator(compounds.Except)
+	suite.Equal("EXCEPT", compound.Operator())
+
+	payload := mocks.NewFakePayload(suite.T())
+	compound.SetPayload(payload)
+	suite.Equal(payload, compound.Payload())
+}
+
+func TestCompoundTestSuite(t *testing.T) {
+	suite.Run(t, new(CompoundTestSuite))
+}
@@ -0,0 +1,15 @@
+package compounds
+
+const (
+	Union = iota
+	UnionAll
+	Intersect
+	Except
+)
+
+var Operator = [...]string{
+	"UNION",
+	"UNION ALL",
+	"INTERSECT",
+	"EXCEPT",
+}
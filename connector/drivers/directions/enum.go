@@ -0,0 +1,11 @@
+package directions
+
+const (
+	Asc = iota
+	Desc
+)
+
+var Direction = [...]string{
+	"ASC",
+	"DESC",
+}
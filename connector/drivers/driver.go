@@ -1,6 +1,7 @@
 package drivers
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -30,10 +31,16 @@ func Get(driver string) (specs.Driver, error) {
 }
 
 // wrapScan is a helper function to wrap the sql.Rows.Scan() function.
-func wrapScan(rows *sql.Rows, resultType []any, onScan func([]any) error) (err error) {
+// The rows are handed to onScan one by one and closed as soon as it fails or the context is cancelled.
+func wrapScan(ctx context.Context, rows *sql.Rows, resultType []any, onScan func([]any) error) (err error) {
 	defer rows.Close()
 
 	for rows.Next() {
+		err = ctx.Err()
+		if err != nil {
+			return
+		}
+
 		tmp := make([]any, len(resultType))
 		copy(tmp, resultType)
 
@@ -47,5 +54,6 @@ func wrapScan(rows *sql.Rows, resultType []any, onScan func([]any) error) (err e
 			return
 		}
 	}
-	return
+
+	return rows.Err()
 }
@@ -53,3 +53,67 @@ func (e *requiredFieldJoinErr) Error() string {
 func NewRequiredFieldJoinErr(fields []string) specs.ErrRequiredFieldJoin {
 	return &requiredFieldJoinErr{fields: fields}
 }
+
+type emptyGroupErr struct {
+	operator string
+}
+
+func (e *emptyGroupErr) Operator() string {
+	return e.operator
+}
+
+func (e *emptyGroupErr) Error() string {
+	return fmt.Sprintf("the group \"%s\" requires one or more conditions", e.Operator())
+}
+
+func NewEmptyGroupErr(operator string) specs.ErrEmptyGroup {
+	return &emptyGroupErr{operator: operator}
+}
+
+type compoundOrderErr struct {
+	field string
+}
+
+func (e *compoundOrderErr) Field() string {
+	return e.field
+}
+
+func (e *compoundOrderErr) Error() string {
+	return fmt.Sprintf("the field \"%s\" must be selected to order a compound query", e.Field())
+}
+
+func NewCompoundOrderErr(field string) specs.ErrCompoundOrder {
+	return &compoundOrderErr{field: field}
+}
+
+type autoIncrementErr struct {
+	increment int64
+}
+
+func (e *autoIncrementErr) Increment() int64 {
+	return e.increment
+}
+
+func (e *autoIncrementErr) Error() string {
+	return fmt.Sprintf("the multi-row inserts require an auto_increment_increment of 1 to deduce the generated ids, got %d", e.Increment())
+}
+
+func NewAutoIncrementErr(increment int64) specs.ErrAutoIncrement {
+	return &autoIncrementErr{increment: increment}
+}
+
+type subqueryDriverErr struct {
+	operator string
+}
+
+func (e *subqueryDriverErr) Operator() string {
+	return e.operator
+}
+
+func (e *subqueryDriverErr) Error() string {
+	return fmt.Sprintf("the subquery of the operator \"%s\" must be rendered by the driver running the query", e.Operator())
+}
+
+func NewSubqueryDriverErr(operator string) specs.ErrSubqueryDriver {
+	return &subqueryDriverErr{operator: operator}
+}
@@ -101,6 +101,7 @@ func (f *field) fnProcess() (fn string, err error) {
 	re := regexp.MustCompile(`\${([a-zA-Z_]+)}`)
 	matches := re.FindAllStringSubmatch(f.Custom(), -1)
 
+	fn = f.Custom()
 	replaceFieldCount := 0
 	for _, match := range matches {
 		for _, arg := range f.CustomArgs() {
@@ -112,7 +113,7 @@ func (f *field) fnProcess() (fn string, err error) {
 				return "", err
 			}
 
-			fn = strings.Replace(f.Custom(), match[0], column, -1)
+			fn = strings.Replace(fn, match[0], column, -1)
 			replaceFieldCount++
 			break
 		}
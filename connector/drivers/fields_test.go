@@ -52,6 +52,24 @@ func (suite *FieldTestSuite) TestColumnWithFn() {
 	assert.True(suite.T(), suite.field.IsCustom())
 }
 
+func (suite *FieldTestSuite) TestColumnWithFnManyArgs() {
+	suite.field.SetCustom("CONCAT(${First}, ' ', ${Last})", []specs.DriverField{NewField().SetName("First").SetColumn("first_name"), NewField().SetName("Last").SetColumn("last_name")})
+
+	column, err := suite.field.Formatted()
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "(CONCAT(`t0`.`first_name`, ' ', `t0`.`last_name`))", column)
+}
+
+func (suite *FieldTestSuite) TestColumnWithFnWithoutArgs() {
+	suite.field.SetCustom("1", nil)
+
+	column, err := suite.field.Formatted()
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "(1)", column)
+}
+
 func (suite *FieldTestSuite) TestColumnWithFnErrNoMatch() {
 	suite.field.SetCustom("CONCAT('%', ${Name}, '%')", []specs.DriverField{NewField().SetName("unknown").SetColumn("name")})
 
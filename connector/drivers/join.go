@@ -11,6 +11,7 @@ type join struct {
 	to   specs.DriverField
 
 	method specs.JoinMethod
+	wheres []specs.DriverWhere
 }
 
 func (j *join) Method() string {
@@ -25,6 +26,11 @@ func (j *join) To() specs.DriverField {
 	return j.to
 }
 
+// Wheres returns the extra conditions appended to the ON clause.
+func (j *join) Wheres() []specs.DriverWhere {
+	return j.wheres
+}
+
 func (j *join) SetMethod(method specs.JoinMethod) specs.DriverJoin {
 	j.method = method
 	return j
@@ -40,6 +46,12 @@ func (j *join) SetTo(field specs.DriverField) specs.DriverJoin {
 	return j
 }
 
+// SetWheres replaces the extra conditions of the ON clause, they are rendered without args so only argument-free operators such as IS NULL fit.
+func (j *join) SetWheres(wheres ...specs.DriverWhere) specs.DriverJoin {
+	j.wheres = wheres
+	return j
+}
+
 func (j *join) toFormatted() (string, error) {
 	formatted, err := j.To().Formatted()
 	if err != nil {
@@ -68,7 +80,16 @@ func (j *join) Formatted() (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("%s %s = %s", j.Method(), toFormatted, fromFormatted), nil
+	formatted := fmt.Sprintf("%s %s = %s", j.Method(), toFormatted, fromFormatted)
+	for _, where := range j.Wheres() {
+		whereFormatted, _, err := where.Formatted()
+		if err != nil {
+			return "", err
+		}
+		formatted += fmt.Sprintf(" AND %s", whereFormatted)
+	}
+
+	return formatted, nil
 }
 
 func (j *join) Validate() error {
@@ -3,6 +3,7 @@ package drivers
 import (
 	"errors"
 	"github.com/kitstack/dbkit/connector/drivers/joins"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
 	"github.com/kitstack/dbkit/tests/mocks"
 	"github.com/stretchr/testify/suite"
 	"testing"
@@ -125,6 +126,28 @@ func (suite *JoinTestSuite) TestJoinWithInvertedCustomField() {
 	suite.Equal("JOIN (CONCAT('%', `t0`.`name`, '%')) = `t0`.`id`", formattedJoin)
 }
 
+func (suite *JoinTestSuite) TestJoinWithWheres() {
+	join := NewJoin().
+		SetFrom(NewField().SetIndex(0).SetColumn("parent_id")).
+		SetTo(NewField().SetIndex(1).SetDatabase("acceptance").SetTable("notes").SetColumn("id")).
+		SetWheres(NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("deleted_at")).SetOperator(operators.IsNull))
+
+	formattedJoin, err := join.Formatted()
+	suite.NoError(err)
+
+	suite.Equal("JOIN `acceptance`.`notes` AS `t1` ON `t1`.`id` = `t0`.`parent_id` AND `t1`.`deleted_at` IS NULL", formattedJoin)
+}
+
+func (suite *JoinTestSuite) TestJoinWithWheresErr() {
+	join := NewJoin().
+		SetFrom(NewField().SetIndex(0).SetColumn("parent_id")).
+		SetTo(NewField().SetIndex(1).SetDatabase("acceptance").SetTable("notes").SetColumn("id")).
+		SetWheres(NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("deleted_at")).SetOperator("unknown"))
+
+	_, err := join.Formatted()
+	suite.EqualError(err, "unknown operator: unknown")
+}
+
 func (suite *JoinTestSuite) TestValidateErr() {
 	join := NewJoin()
 
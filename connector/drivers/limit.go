@@ -3,6 +3,7 @@ package drivers
 import (
 	"fmt"
 	"github.com/kitstack/dbkit/specs"
+	"math"
 )
 
 type limit struct {
@@ -28,7 +29,20 @@ func (l *limit) SetLimit(index int) specs.DriverLimit {
 	return l
 }
 
+// allRows is the row count read after an offset without limit, MySQL having no OFFSET clause without LIMIT.
+const allRows = uint64(math.MaxUint64)
+
+// Formatted renders the limit clause, a limit of 0 reads every row. An offset without limit is rendered with allRows,
+// to skip the offset rows and return all the remaining ones, no clause is rendered without limit nor offset.
 func (l *limit) Formatted() (string, error) {
+	if l.Limit() == 0 && l.Offset() == 0 {
+		return "", nil
+	}
+
+	if l.Limit() == 0 {
+		return fmt.Sprintf("LIMIT %d, %d", l.Offset(), allRows), nil
+	}
+
 	return fmt.Sprintf("LIMIT %d, %d", l.Offset(), l.Limit()), nil
 }
 
@@ -25,6 +25,18 @@ func (suite *LimitTestSuite) TestLimit() {
 	suite.Equal("LIMIT 1, 2", formatted)
 }
 
+func (suite *LimitTestSuite) TestOffsetWithoutLimit() {
+	formatted, err := NewLimit().SetOffset(5).Formatted()
+	suite.NoError(err)
+	suite.Equal("LIMIT 5, 18446744073709551615", formatted)
+}
+
+func (suite *LimitTestSuite) TestWithoutLimitNorOffset() {
+	formatted, err := NewLimit().Formatted()
+	suite.NoError(err)
+	suite.Empty(formatted)
+}
+
 func TestLimitTestSuite(t *testing.T) {
 	suite.Run(t, new(LimitTestSuite))
 }
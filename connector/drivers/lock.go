@@ -0,0 +1,43 @@
+package drivers
+
+import (
+	"fmt"
+	"github.com/kitstack/dbkit/connector/drivers/locks"
+	"github.com/kitstack/dbkit/specs"
+)
+
+type lock struct {
+	strength specs.LockStrength
+	option   specs.LockOption
+}
+
+func (l *lock) Strength() string {
+	return locks.Strength[l.strength]
+}
+
+func (l *lock) Option() string {
+	return locks.Option[l.option]
+}
+
+func (l *lock) SetStrength(strength specs.LockStrength) specs.DriverLock {
+	l.strength = strength
+	return l
+}
+
+func (l *lock) SetOption(option specs.LockOption) specs.DriverLock {
+	l.option = option
+	return l
+}
+
+// Formatted renders the locking clause ending a select, followed by the option telling how to handle the rows already locked.
+func (l *lock) Formatted() (string, error) {
+	if l.Option() == "" {
+		return l.Strength(), nil
+	}
+
+	return fmt.Sprintf("%s %s", l.Strength(), l.Option()), nil
+}
+
+func NewLock() specs.DriverLock {
+	return new(lock)
+}
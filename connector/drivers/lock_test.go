@@ -0,0 +1,38 @@
+package drivers
+
+import (
+	"github.com/kitstack/dbkit/connector/drivers/locks"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type LockTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LockTestSuite) TestLock() {
+	lock := NewLock()
+	suite.Equal("", lock.Strength())
+	suite.Equal("", lock.Option())
+
+	lock.SetStrength(locks.ForUpdate)
+	suite.Equal("FOR UPDATE", lock.Strength())
+
+	formatted, err := lock.Formatted()
+	suite.NoError(err)
+	suite.Equal("FOR UPDATE", formatted)
+}
+
+func (suite *LockTestSuite) TestLockWithOption() {
+	formatted, err := NewLock().SetStrength(locks.ForShare).SetOption(locks.NoWait).Formatted()
+	suite.NoError(err)
+	suite.Equal("FOR SHARE NOWAIT", formatted)
+
+	formatted, err = NewLock().SetStrength(locks.ForUpdate).SetOption(locks.SkipLocked).Formatted()
+	suite.NoError(err)
+	suite.Equal("FOR UPDATE SKIP LOCKED", formatted)
+}
+
+func TestLockTestSuite(t *testing.T) {
+	suite.Run(t, new(LockTestSuite))
+}
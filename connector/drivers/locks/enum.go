@@ -0,0 +1,25 @@
+package locks
+
+const (
+	None = iota
+	ForUpdate
+	ForShare
+)
+
+var Strength = [...]string{
+	"",
+	"FOR UPDATE",
+	"FOR SHARE",
+}
+
+const (
+	Wait = iota
+	SkipLocked
+	NoWait
+)
+
+var Option = [...]string{
+	"",
+	"SKIP LOCKED",
+	"NOWAIT",
+}
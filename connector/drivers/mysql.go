@@ -9,12 +9,18 @@ import (
 	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/depkit"
 	log "github.com/sirupsen/logrus"
+	"strings"
 )
 
 func init() {
 	depkit.Register[specs.SqlIn](sqlx.In)
 }
 
+var (
+	// mysqlMaxPlaceholders is the number of placeholders accepted by a prepared statement.
+	mysqlMaxPlaceholders = 65535
+)
+
 type Mysql struct {
 	specs.Config
 	db *sql.DB
@@ -82,13 +88,33 @@ func (m *Mysql) buildJoin(joins []specs.DriverJoin) (result string, err error) {
 }
 
 func (m *Mysql) buildWhere(wheres []specs.DriverWhere) (result string, args []any, err error) {
+	result, args, err = m.buildConditions(wheres)
+
+	if result != "" {
+		result = fmt.Sprintf("WHERE %s", result)
+	}
+
+	return
+}
+
+func (m *Mysql) buildHaving(having []specs.DriverWhere) (result string, args []any, err error) {
+	result, args, err = m.buildConditions(having)
+
+	if result != "" {
+		result = fmt.Sprintf("HAVING %s", result)
+	}
+
+	return
+}
+
+func (m *Mysql) buildConditions(wheres []specs.DriverWhere) (result string, args []any, err error) {
 	for i, where := range wheres {
 
 		if i > 0 {
 			result += " AND "
 		}
 
-		formatted, whereArgs, err := where.Formatted()
+		formatted, whereArgs, err := formatWhere(where, m)
 		if err != nil {
 			return "", nil, err
 		}
@@ -100,8 +126,75 @@ func (m *Mysql) buildWhere(wheres []specs.DriverWhere) (result string, args []an
 		}
 	}
 
+	return
+}
+
+func (m *Mysql) buildGroupBy(groups []specs.DriverField) (result string, err error) {
+	result, err = m.buildFields(groups)
+
 	if result != "" {
-		result = fmt.Sprintf("WHERE %s", result)
+		result = fmt.Sprintf("GROUP BY %s", result)
+	}
+
+	return
+}
+
+func (m *Mysql) buildOrderBy(orders []specs.DriverOrder) (result string, err error) {
+	for i, order := range orders {
+		if i > 0 {
+			result += ", "
+		}
+
+		formatted, err := order.Formatted()
+		if err != nil {
+			return "", err
+		}
+
+		result += formatted
+	}
+
+	if result != "" {
+		result = fmt.Sprintf("ORDER BY %s", result)
+	}
+
+	return
+}
+
+// buildCompoundOrderBy renders the ordering of a compound query, the columns of the combined result have no table
+// so each field is referred to by its position in the selected fields.
+func (m *Mysql) buildCompoundOrderBy(orders []specs.DriverOrder, fields []specs.DriverField) (result string, err error) {
+	positions := map[string]int{}
+	for i, field := range fields {
+		formatted, err := field.Formatted()
+		if err != nil {
+			return "", err
+		}
+
+		if _, ok := positions[formatted]; !ok {
+			positions[formatted] = i + 1
+		}
+	}
+
+	for i, order := range orders {
+		if i > 0 {
+			result += ", "
+		}
+
+		formatted, err := order.Field().Formatted()
+		if err != nil {
+			return "", err
+		}
+
+		position, ok := positions[formatted]
+		if !ok {
+			return "", NewCompoundOrderErr(formatted)
+		}
+
+		result += fmt.Sprintf("%d %s", position, order.Direction())
+	}
+
+	if result != "" {
+		result = fmt.Sprintf("ORDER BY %s", result)
 	}
 
 	return
@@ -115,35 +208,227 @@ func (m *Mysql) buildLimit(limit specs.DriverLimit) (result string, err error) {
 	return limit.Formatted()
 }
 
+func (m *Mysql) buildLock(lock specs.DriverLock) (result string, err error) {
+	if lock == nil {
+		return
+	}
+
+	return lock.Formatted()
+}
+
+func (m *Mysql) buildValues(values []specs.DriverWhere) (columns string, placeholders string, args []any) {
+	for i, value := range values {
+		if i > 0 {
+			columns += ", "
+			placeholders += ", "
+		}
+
+		columns += fmt.Sprintf("`%s`", value.From().Column())
+		placeholders += "?"
+		args = append(args, value.To())
+	}
+
+	return
+}
+
+// buildOnDuplicate renders the assignments of an upsert, each column takes the inserted value back,
+// the primary key goes through LAST_INSERT_ID so that the id of an updated row is reported like an inserted one.
+func (m *Mysql) buildOnDuplicate(fields []specs.DriverField, primaryKey specs.DriverField) (result string) {
+	var assignments []string
+	for _, field := range fields {
+		assignments = append(assignments, fmt.Sprintf("`%s` = VALUES(`%s`)", field.Column(), field.Column()))
+	}
+
+	if primaryKey != nil {
+		assignments = append(assignments, fmt.Sprintf("`%s` = LAST_INSERT_ID(`%s`)", primaryKey.Column(), primaryKey.Column()))
+	}
+
+	if len(assignments) > 0 {
+		result = fmt.Sprintf("ON DUPLICATE KEY UPDATE %s", strings.Join(assignments, ", "))
+	}
+
+	return
+}
+
+func (m *Mysql) buildSet(values []specs.DriverWhere) (result string, args []any, err error) {
+	for i, value := range values {
+		if i > 0 {
+			result += ", "
+		}
+
+		formatted, valueArgs, err := value.Formatted()
+		if err != nil {
+			return "", nil, err
+		}
+
+		result += formatted
+		args = append(args, valueArgs...)
+	}
+
+	if result != "" {
+		result = fmt.Sprintf("SET %s", result)
+	}
+
+	return
+}
+
 // Db is a helper function to get the database connection.
 func (m *Mysql) Db() *sql.DB {
 	return m.db
 }
 
-// Select TODO: add options for passing tx
+// executor returns the transaction carried by the context, or the pool when there is none.
+func (m *Mysql) executor(ctx context.Context) specs.Executor {
+	if tx, ok := TxFromContext(ctx, m.db); ok {
+		return tx
+	}
+
+	return m.db
+}
+
+// buildSelect renders the SELECT statement of the payload without running it,
+// it is shared by Select and by the subqueries used as the value of a where, both are rendered in the database given.
+func (m *Mysql) buildSelect(payload specs.Payload, database string) (string, []any, error) {
+	query, err := m.buildQuery(payload, database)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return query.String(), query.Args(), nil
+}
+
+// buildQuery assembles the clauses of the SELECT statement of the payload, along with the statements of its compounds,
+// all of them read from the database given.
+func (m *Mysql) buildQuery(payload specs.Payload, database string) (*query, error) {
+	buildFields, err := m.buildFields(payload.Fields())
+	if err != nil {
+		return nil, err
+	}
+
+	builtWhere, args, err := m.buildWhere(payload.Where())
+	if err != nil {
+		return nil, err
+	}
+
+	builtJoin, err := m.buildJoin(payload.Join())
+	if err != nil {
+		return nil, err
+	}
+
+	builtGroupBy, err := m.buildGroupBy(payload.GroupBy())
+	if err != nil {
+		return nil, err
+	}
+
+	builtHaving, havingArgs, err := m.buildHaving(payload.Having())
+	if err != nil {
+		return nil, err
+	}
+
+	compounds := payload.Compounds()
+
+	var builtOrderBy string
+	if len(compounds) > 0 {
+		builtOrderBy, err = m.buildCompoundOrderBy(payload.OrderBy(), payload.Fields())
+	} else {
+		builtOrderBy, err = m.buildOrderBy(payload.OrderBy())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	buildLimit, err := m.buildLimit(payload.Limit())
+	if err != nil {
+		return nil, err
+	}
+
+	builtLock, err := m.buildLock(payload.Lock())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &query{
+		distinct: payload.Distinct(),
+		fields:   buildFields,
+		from:     fmt.Sprintf("`%s`.`%s` AS `t%d`", database, payload.Table(), payload.Index()),
+		join:     builtJoin,
+		where:    builtWhere,
+		groupBy:  builtGroupBy,
+		having:   builtHaving,
+		orderBy:  builtOrderBy,
+		limit:    buildLimit,
+		lock:     builtLock,
+		args:     append(args, havingArgs...),
+	}
+
+	for _, compound := range compounds {
+		other, err := m.buildQuery(compound.Payload(), database)
+		if err != nil {
+			return nil, err
+		}
+
+		result.combine(compound.Operator(), other)
+	}
+
+	return result, nil
+}
+
 // Select is a helper function to select data from database.
 func (m *Mysql) Select(ctx context.Context, payload specs.Payload) (err error) {
-	buildFields, err := m.buildFields(payload.Fields())
+	query, args, err := m.buildSelect(payload, m.Database())
 	if err != nil {
 		return
 	}
 
-	builtWhere, args, err := m.buildWhere(payload.Where())
+	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(query, args...)
 	if err != nil {
 		return
 	}
 
-	builtJoin, err := m.buildJoin(payload.Join())
+	mapping, err := payload.Mapping()
 	if err != nil {
 		return
 	}
 
-	buildLimit, err := m.buildLimit(payload.Limit())
+	log.WithFields(log.Fields{
+		"type":  "select",
+		"query": queryWithArgs,
+		"args":  args,
+	}).Debug("Execute: Select()")
+
+	rows, err := m.executor(ctx).QueryContext(ctx, queryWithArgs, args...)
 	if err != nil {
 		return
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM `%s`.`%s` AS `t%d`", buildFields, m.Database(), payload.Table(), payload.Index())
+	return wrapScan(ctx, rows, mapping, payload.OnScan)
+}
+
+// Count is a helper function to count the rows matching the conditions in database.
+func (m *Mysql) Count(ctx context.Context, payload specs.Payload) (total int64, err error) {
+	builtWhere, args, err := m.buildWhere(payload.Where())
+	if err != nil {
+		return
+	}
+
+	builtJoin, err := m.buildJoin(payload.Join())
+	if err != nil {
+		return
+	}
+
+	// The fields of the payload (e.g. the primary key) count the rows repeated by a join once.
+	count := "*"
+	if len(payload.Fields()) > 0 {
+		var builtFields string
+		builtFields, err = m.buildFields(payload.Fields())
+		if err != nil {
+			return
+		}
+
+		count = fmt.Sprintf("DISTINCT %s", builtFields)
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(%s) FROM `%s`.`%s` AS `t%d`", count, m.Database(), payload.Table(), payload.Index())
 
 	if builtJoin != "" {
 		query += fmt.Sprintf(" %s", builtJoin)
@@ -153,32 +438,281 @@ func (m *Mysql) Select(ctx context.Context, payload specs.Payload) (err error) {
 		query += fmt.Sprintf(" %s", builtWhere)
 	}
 
-	if buildLimit != "" {
-		query += fmt.Sprintf(" %s", buildLimit)
+	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(query, args...)
+	if err != nil {
+		return
 	}
 
-	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(query, args...)
+	log.WithFields(log.Fields{
+		"type":  "count",
+		"query": queryWithArgs,
+		"args":  args,
+	}).Debug("Execute: Count()")
+
+	err = m.executor(ctx).QueryRowContext(ctx, queryWithArgs, args...).Scan(&total)
+
+	return
+}
+
+// Raw runs the query of the payload as it is written, the fields of the payload are resolved from the columns of the result.
+func (m *Mysql) Raw(ctx context.Context, payload specs.PayloadRaw) (err error) {
+	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(payload.Query(), payload.Args()...)
+	if err != nil {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"type":  "raw",
+		"query": queryWithArgs,
+		"args":  args,
+	}).Debug("Execute: Raw()")
+
+	rows, err := m.executor(ctx).QueryContext(ctx, queryWithArgs, args...)
+	if err != nil {
+		return
+	}
+
+	columns, err := rows.Columns()
 	if err != nil {
+		_ = rows.Close()
+		return
+	}
+
+	err = payload.SetColumns(columns)
+	if err != nil {
+		_ = rows.Close()
 		return
 	}
 
 	mapping, err := payload.Mapping()
 	if err != nil {
+		_ = rows.Close()
 		return
 	}
 
+	return wrapScan(ctx, rows, mapping, payload.OnScan)
+}
+
+// Insert is a helper function to insert data into database.
+func (m *Mysql) Insert(ctx context.Context, payload specs.Payload) (result sql.Result, err error) {
+	columns, placeholders, args := m.buildValues(payload.Values())
+
+	query := fmt.Sprintf("INSERT INTO `%s`.`%s` (%s) VALUES (%s)", m.Database(), payload.Table(), columns, placeholders)
+
 	log.WithFields(log.Fields{
-		"type":  "select",
+		"type":  "insert",
+		"query": query,
+		"args":  args,
+	}).Debug("Execute: Insert()")
+
+	return m.executor(ctx).ExecContext(ctx, query, args...)
+}
+
+// InsertMany is a helper function to insert several rows into database with multi-row statements,
+// the rows are split into as many statements as required to stay under the placeholder and packet limits.
+// Every row of the payload is expected to write the same columns.
+// The ids generated by a statement are consecutive from its LastInsertId, the statements are refused
+// when auto_increment_increment is not 1 as the ids of the rows could not be deduced.
+func (m *Mysql) InsertMany(ctx context.Context, payload specs.Payload) (results []sql.Result, err error) {
+	statements := m.splitRows(payload.Rows())
+
+	err = m.valideAutoIncrement(ctx, statements)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rows := range statements {
+		columns, _, _ := m.buildValues(rows[0])
+
+		var placeholders []string
+		var args []any
+		for _, row := range rows {
+			_, rowPlaceholders, rowArgs := m.buildValues(row)
+			placeholders = append(placeholders, fmt.Sprintf("(%s)", rowPlaceholders))
+			args = append(args, rowArgs...)
+		}
+
+		query := fmt.Sprintf("INSERT INTO `%s`.`%s` (%s) VALUES %s", m.Database(), payload.Table(), columns, strings.Join(placeholders, ", "))
+
+		log.WithFields(log.Fields{
+			"type":  "insert",
+			"query": query,
+			"args":  args,
+		}).Debug("Execute: InsertMany()")
+
+		result, err := m.executor(ctx).ExecContext(ctx, query, args...)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// valideAutoIncrement refuses the multi-row statements when the ids generated by a statement are not consecutive.
+func (m *Mysql) valideAutoIncrement(ctx context.Context, statements [][][]specs.DriverWhere) error {
+	multiRow := false
+	for _, rows := range statements {
+		multiRow = multiRow || len(rows) > 1
+	}
+
+	if !multiRow {
+		return nil
+	}
+
+	var increment int64
+	err := m.executor(ctx).QueryRowContext(ctx, "SELECT @@SESSION.auto_increment_increment").Scan(&increment)
+	if err != nil {
+		return err
+	}
+
+	if increment != 1 {
+		return NewAutoIncrementErr(increment)
+	}
+
+	return nil
+}
+
+// splitRows groups the rows into statements holding at most mysqlMaxPlaceholders placeholders,
+// with an estimated size under the max_allowed_packet of the config. A row is never split, even when it is larger on its own.
+func (m *Mysql) splitRows(rows [][]specs.DriverWhere) (statements [][][]specs.DriverWhere) {
+	var current [][]specs.DriverWhere
+	placeholders, size := 0, 0
+	for _, row := range rows {
+		rowSize := 0
+		for _, value := range row {
+			rowSize += m.estimateSize(value.To()) + len("?, ")
+		}
+
+		if len(current) > 0 && (placeholders+len(row) > mysqlMaxPlaceholders || size+rowSize > m.MaxAllowedPacket()) {
+			statements = append(statements, current)
+			current, placeholders, size = nil, 0, 0
+		}
+
+		current = append(current, row)
+		placeholders += len(row)
+		size += rowSize
+	}
+
+	if len(current) > 0 {
+		statements = append(statements, current)
+	}
+
+	return
+}
+
+// estimateSize returns the number of bytes a value takes in a statement, a fixed size is assumed for the numbers and the dates.
+func (m *Mysql) estimateSize(value any) int {
+	switch current := value.(type) {
+	case string:
+		return len(current)
+	case []byte:
+		return len(current)
+	}
+
+	return 8
+}
+
+// Upsert is a helper function to insert data into database, or to update the columns of the fields on a duplicate key.
+// The rows affected are 1 for an inserted row, 2 for an updated one and 0 when the row is left unchanged.
+func (m *Mysql) Upsert(ctx context.Context, payload specs.Payload) (result sql.Result, err error) {
+	columns, placeholders, args := m.buildValues(payload.Values())
+
+	query := fmt.Sprintf("INSERT INTO `%s`.`%s` (%s) VALUES (%s)", m.Database(), payload.Table(), columns, placeholders)
+
+	builtOnDuplicate := m.buildOnDuplicate(payload.Fields(), payload.PrimaryKey())
+	if builtOnDuplicate != "" {
+		query += fmt.Sprintf(" %s", builtOnDuplicate)
+	}
+
+	log.WithFields(log.Fields{
+		"type":  "upsert",
+		"query": query,
+		"args":  args,
+	}).Debug("Execute: Upsert()")
+
+	return m.executor(ctx).ExecContext(ctx, query, args...)
+}
+
+// Update is a helper function to update data in database.
+func (m *Mysql) Update(ctx context.Context, payload specs.Payload) (result sql.Result, err error) {
+	builtSet, setArgs, err := m.buildSet(payload.Values())
+	if err != nil {
+		return
+	}
+
+	builtWhere, whereArgs, err := m.buildWhere(payload.Where())
+	if err != nil {
+		return
+	}
+
+	builtJoin, err := m.buildJoin(payload.Join())
+	if err != nil {
+		return
+	}
+
+	query := fmt.Sprintf("UPDATE `%s`.`%s` AS `t%d`", m.Database(), payload.Table(), payload.Index())
+
+	if builtJoin != "" {
+		query += fmt.Sprintf(" %s", builtJoin)
+	}
+
+	query += fmt.Sprintf(" %s", builtSet)
+
+	if builtWhere != "" {
+		query += fmt.Sprintf(" %s", builtWhere)
+	}
+
+	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(query, append(setArgs, whereArgs...)...)
+	if err != nil {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"type":  "update",
 		"query": queryWithArgs,
 		"args":  args,
-	}).Debug("Execute: Select()")
+	}).Debug("Execute: Update()")
+
+	return m.executor(ctx).ExecContext(ctx, queryWithArgs, args...)
+}
+
+// Delete is a helper function to delete data from database.
+func (m *Mysql) Delete(ctx context.Context, payload specs.Payload) (result sql.Result, err error) {
+	builtWhere, args, err := m.buildWhere(payload.Where())
+	if err != nil {
+		return
+	}
+
+	builtJoin, err := m.buildJoin(payload.Join())
+	if err != nil {
+		return
+	}
+
+	query := fmt.Sprintf("DELETE `t%d` FROM `%s`.`%s` AS `t%d`", payload.Index(), m.Database(), payload.Table(), payload.Index())
+
+	if builtJoin != "" {
+		query += fmt.Sprintf(" %s", builtJoin)
+	}
+
+	if builtWhere != "" {
+		query += fmt.Sprintf(" %s", builtWhere)
+	}
 
-	rows, err := m.Db().QueryContext(ctx, queryWithArgs, args...)
+	queryWithArgs, args, err := depkit.Get[specs.SqlIn]()(query, args...)
 	if err != nil {
 		return
 	}
 
-	return wrapScan(rows, mapping, payload.OnScan)
+	log.WithFields(log.Fields{
+		"type":  "delete",
+		"query": queryWithArgs,
+		"args":  args,
+	}).Debug("Execute: Delete()")
+
+	return m.executor(ctx).ExecContext(ctx, queryWithArgs, args...)
 }
 
 func (m *Mysql) Get() *sql.DB {
@@ -6,6 +6,10 @@ import (
 	"database/sql/driver"
 	"errors"
 	"github.com/kitstack/dbkit/connector/config"
+	"github.com/kitstack/dbkit/connector/drivers/compounds"
+	"github.com/kitstack/dbkit/connector/drivers/directions"
+	"github.com/kitstack/dbkit/connector/drivers/locks"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
 	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/dbkit/tests/mocks"
 	"github.com/kitstack/dbkit/tests/mocks/fakesql"
@@ -29,6 +33,7 @@ type MysqlTestSuite struct {
 	fakeDriverField *mocks.FakeDriverField
 
 	fakeDriverLimit *mocks.FakeDriverLimit
+	fakeDriverLock  *mocks.FakeDriverLock
 	fakeDriverJoin  *mocks.FakeDriverJoin
 	fakeDriverWhere *mocks.FakeDriverWhere
 	fakeSqlIn       *mocks.FakeSqlIn
@@ -53,6 +58,7 @@ func (test *MysqlTestSuite) SetupTest() {
 	test.fakePayload = mocks.NewFakePayload(test.T())
 	test.fakeIn = mocks.NewFakeIn(test.T())
 	test.fakeDriverLimit = mocks.NewFakeDriverLimit(test.T())
+	test.fakeDriverLock = mocks.NewFakeDriverLock(test.T())
 	test.fakeDriverField = mocks.NewFakeDriverField(test.T())
 	test.fakeDriverJoin = mocks.NewFakeDriverJoin(test.T())
 	test.fakeDriverWhere = mocks.NewFakeDriverWhere(test.T())
@@ -144,6 +150,28 @@ func (test *MysqlTestSuite) TestBuildLimit() {
 	test.EqualValues("LIMIT 0, 1", limitValue)
 }
 
+func (test *MysqlTestSuite) TestBuildLock() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test"))
+	if !test.Empty(err) {
+		return
+	}
+
+	lockValue, err := drv.(*Mysql).buildLock(nil)
+	test.NoError(err)
+	test.Empty(lockValue)
+
+	test.fakeDriverLock.On("Formatted").Return("FOR UPDATE NOWAIT", nil)
+
+	lockValue, err = drv.(*Mysql).buildLock(test.fakeDriverLock)
+	test.NoError(err)
+	test.EqualValues("FOR UPDATE NOWAIT", lockValue)
+}
+
 func (test *MysqlTestSuite) TestBuildJoin() {
 	drv, err := Get("test")
 	if !test.Empty(err) {
@@ -211,7 +239,13 @@ func (test *MysqlTestSuite) TestSelectErr() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(test.fakeDriverLimit)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	test.fakeDriverLimit.On("Formatted").Return("LIMIT 0, 1", nil)
 
@@ -249,7 +283,13 @@ func (test *MysqlTestSuite) TestSelectMappingErr() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id`, `t0`.`email` FROM `acceptance`.`users` AS `t0`"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -284,7 +324,13 @@ func (test *MysqlTestSuite) TestSimpleWhere() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` WHERE `t0`.`id` = ?"
 	test.fakeSqlIn.On("Execute", query, 1).Return(query, []any{1}, nil)
@@ -345,7 +391,13 @@ func (test *MysqlTestSuite) TestSimpleWhereIsNullOperator() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("test")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`test` AS `t0` WHERE `t0`.`id` IS NULL"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -383,7 +435,13 @@ func (test *MysqlTestSuite) TestSimpleWhereInOperator() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("test")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`test` AS `t0` WHERE `t0`.`id` IN (?)"
 	test.fakeSqlIn.On("Execute", query).Return(strings.Replace(query, "?", "?, ?", -1), []any{}, nil)
@@ -421,7 +479,13 @@ func (test *MysqlTestSuite) TestSimpleWhereInOperatorErr() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("test")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	fnErrorMsg := "function `GenerateInArgument` returns an error"
 	query := "SELECT `t0`.`id` FROM `acceptance`.`test` AS `t0` WHERE `t0`.`id` IN (?)"
@@ -460,7 +524,13 @@ func (test *MysqlTestSuite) TestWhereMultiEqual() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` WHERE `t0`.`id` = ? AND `t0`.`email` = ?"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -492,7 +562,13 @@ func (test *MysqlTestSuite) TestSelect() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Table").Return("test")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`test` AS `t0`"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -525,6 +601,110 @@ func (test *MysqlTestSuite) TestSelect() {
 	test.Empty(err)
 }
 
+func (test *MysqlTestSuite) TestRaw() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	query := "SELECT `id`, `email` AS `User.Email` FROM `users` WHERE `id` = ?"
+	fakePayloadRaw := mocks.NewFakePayloadRaw(test.T())
+	fakePayloadRaw.On("Query").Return(query)
+	fakePayloadRaw.On("Args").Return([]any{1})
+	fakePayloadRaw.On("SetColumns", []string{"id", "User.Email"}).Return(nil)
+
+	test.fakeSqlIn.On("Execute", query, 1).Return(query, []any{1}, nil)
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(1)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeStmt.On("Query", []driver.Value{int64(1)}).Return(test.fakeRows, nil)
+	test.fakeRows.On("Columns").Return([]string{"id", "User.Email"})
+	test.fakeRows.On("Close").Return(nil)
+
+	mapping := []any{new(uint64), new(string)}
+	fakePayloadRaw.On("Mapping").Return(mapping, nil)
+	fakePayloadRaw.On("OnScan", mapping).Return(nil).Once()
+
+	var line = 0
+	test.fakeRows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = 1
+		dest[1] = "test@test.com"
+
+		if line < 1 {
+			line++
+			return nil
+		}
+
+		return io.EOF
+	})
+
+	err = drv.Raw(context.Background(), fakePayloadRaw)
+	test.NoError(err)
+}
+
+func (test *MysqlTestSuite) TestRawSetColumnsErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	query := "SELECT `unknown` FROM `users`"
+	fakePayloadRaw := mocks.NewFakePayloadRaw(test.T())
+	fakePayloadRaw.On("Query").Return(query)
+	fakePayloadRaw.On("Args").Return([]any{})
+	fakePayloadRaw.On("SetColumns", []string{"unknown"}).Return(errors.New("set_columns_err"))
+
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(0)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeStmt.On("Query", []driver.Value{}).Return(test.fakeRows, nil)
+	test.fakeRows.On("Columns").Return([]string{"unknown"})
+	test.fakeRows.On("Close").Return(nil)
+
+	err = drv.Raw(context.Background(), fakePayloadRaw)
+	test.EqualError(err, "set_columns_err")
+}
+
+func (test *MysqlTestSuite) TestRawErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	query := "SELECT `id` FROM `users`"
+	fakePayloadRaw := mocks.NewFakePayloadRaw(test.T())
+	fakePayloadRaw.On("Query").Return(query)
+	fakePayloadRaw.On("Args").Return([]any{})
+
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	err = drv.Raw(context.Background(), fakePayloadRaw)
+	test.EqualError(err, "prepare_err")
+}
+
 func (test *MysqlTestSuite) TestSelectWithNativeScanErr() {
 	drv, err := Get("test")
 	if !test.Empty(err) {
@@ -547,7 +727,13 @@ func (test *MysqlTestSuite) TestSelectWithNativeScanErr() {
 	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0`"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -601,7 +787,13 @@ func (test *MysqlTestSuite) TestSelectWithNativeWrapScanErr() {
 	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0`"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -635,6 +827,133 @@ func (test *MysqlTestSuite) TestSelectWithNativeWrapScanErr() {
 	test.Error(err)
 }
 
+func (test *MysqlTestSuite) TestSelectWithRowsErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakeDriverField.On("Formatted").Return("`t0`.`id`", nil).Once()
+	test.fakePayload.On("Fields").Return([]specs.DriverField{
+		test.fakeDriverField,
+	})
+
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+
+	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0`"
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(0)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeRows.On("Columns").Return([]string{"id"})
+	test.fakeRows.On("Close").Return(nil)
+
+	mapping := []any{new(uint64)}
+	test.fakePayload.On("Mapping").Return(mapping, nil)
+
+	test.fakeStmt.On("Query", []driver.Value{}).Return(test.fakeRows, nil)
+
+	var line = 0
+	test.fakeRows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = 1
+
+		if line < 1 {
+			line++
+			return nil
+		}
+
+		return errors.New("rows_err")
+	})
+
+	test.fakePayload.On("OnScan", mapping).Return(nil).Once()
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "rows_err")
+}
+
+func (test *MysqlTestSuite) TestSelectWithContextCanceled() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakeDriverField.On("Formatted").Return("`t0`.`id`", nil).Once()
+	test.fakePayload.On("Fields").Return([]specs.DriverField{
+		test.fakeDriverField,
+	})
+
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+
+	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0`"
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(0)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeRows.On("Columns").Return([]string{"id"})
+	test.fakeRows.On("Close").Return(nil)
+
+	mapping := []any{new(uint64)}
+	test.fakePayload.On("Mapping").Return(mapping, nil)
+
+	test.fakeStmt.On("Query", []driver.Value{}).Return(test.fakeRows, nil)
+
+	var line = 0
+	test.fakeRows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = 1
+
+		if line < 2 {
+			line++
+			return nil
+		}
+
+		return io.EOF
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	test.fakePayload.On("OnScan", mapping).Run(func(args mock.Arguments) {
+		cancel()
+	}).Return(nil).Once()
+
+	err = drv.Select(ctx, test.fakePayload)
+	test.ErrorIs(err, context.Canceled)
+}
+
 func (test *MysqlTestSuite) TestSelectWithWhere() {
 	drv, err := Get("test")
 	if !test.Empty(err) {
@@ -657,7 +976,13 @@ func (test *MysqlTestSuite) TestSelectWithWhere() {
 	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
 	test.fakePayload.On("Table").Return("users")
 	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 
 	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0`"
 	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
@@ -715,7 +1040,13 @@ func (test *MysqlTestSuite) TestJoin() {
 	test.fakePayload.On("Join").Return([]specs.DriverJoin{
 		test.fakeDriverJoin,
 	})
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 	test.fakePayload.On("Table").Return("comments")
 	test.fakePayload.On("Index").Return(0)
 
@@ -760,7 +1091,13 @@ func (test *MysqlTestSuite) TestMultiJoin() {
 		test.fakeDriverJoin,
 		test.fakeDriverJoin,
 	})
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
 	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
 	test.fakePayload.On("Table").Return("comments")
 	test.fakePayload.On("Index").Return(0)
 
@@ -824,7 +1161,7 @@ func (test *MysqlTestSuite) TestJoinValidateErr() {
 	test.Contains(err.Error(), "select_join_validate_err")
 }
 
-func (test *MysqlTestSuite) TestLimitErr() {
+func (test *MysqlTestSuite) TestSelectWithOrderBy() {
 	drv, err := Get("test")
 	if !test.Empty(err) {
 		return
@@ -835,22 +1172,1148 @@ func (test *MysqlTestSuite) TestLimitErr() {
 		return
 	}
 
-	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("id")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
 	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return([]specs.DriverOrder{
+		NewOrder().SetField(NewField().SetColumn("created_at")).SetDirection(directions.Desc),
+		NewOrder().SetField(NewField().SetColumn("id")),
+	})
+	test.fakePayload.On("Limit").Return(NewLimit().SetLimit(10))
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
 
-	test.fakeDriverJoin.On("Validate").Return(nil)
-	test.fakeDriverJoin.On("Formatted").Return("", nil)
+	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` ORDER BY `t0`.`created_at` DESC, `t0`.`id` ASC LIMIT 0, 10"
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
 
-	test.fakePayload.On("Join").Return([]specs.DriverJoin{
-		test.fakeDriverJoin,
-	})
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
 
-	test.fakePayload.On("Limit").Return(test.fakeDriverLimit)
-	test.fakeDriverLimit.On("Formatted").Return("", errors.New("select_limit_formatted_err"))
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestSelectWithLock() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("id")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(NewLimit().SetLimit(10))
+	test.fakePayload.On("Lock").Return(NewLock().SetStrength(locks.ForUpdate).SetOption(locks.SkipLocked))
+	test.fakePayload.On("Distinct").Return(false)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
+
+	query := "SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` LIMIT 0, 10 FOR UPDATE SKIP LOCKED"
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
 
 	err = drv.Select(context.Background(), test.fakePayload)
-	test.Error(err)
-	test.Contains(err.Error(), "select_limit_formatted_err")
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestSelectWithDistinct() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("email")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(true)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
+
+	query := "SELECT DISTINCT `t0`.`email` FROM `acceptance`.`users` AS `t0`"
+	test.fakeSqlIn.On("Execute", query).Return(query, []any{}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) newCompoundPayload(index int, id int) *mocks.FakePayload {
+	payload := mocks.NewFakePayload(test.T())
+	payload.On("Fields").Return([]specs.DriverField{NewField().SetIndex(index).SetColumn("id")})
+	payload.On("Join").Return([]specs.DriverJoin{})
+	payload.On("Where").Return([]specs.DriverWhere{NewWhere().SetFrom(NewField().SetIndex(index).SetColumn("id")).SetOperator(operators.Equal).SetTo(id)})
+	payload.On("Database").Return("model_database").Maybe()
+	payload.On("Table").Return("users")
+	payload.On("Index").Return(index)
+	payload.On("GroupBy").Return(nil)
+	payload.On("Having").Return(nil)
+	payload.On("Compounds").Return(nil)
+	payload.On("OrderBy").Return(nil)
+	payload.On("Limit").Return(nil)
+	payload.On("Lock").Return(nil)
+	payload.On("Distinct").Return(false)
+
+	return payload
+}
+
+func (test *MysqlTestSuite) TestSelectWithCompound() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("id"), NewField().SetColumn("email")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{NewWhere().SetFrom(NewField().SetColumn("id")).SetOperator(operators.Equal).SetTo(1)})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return([]specs.DriverCompound{
+		NewCompound().SetOperator(compounds.UnionAll).SetPayload(test.newCompoundPayload(1, 2)),
+		NewCompound().SetOperator(compounds.Except).SetPayload(test.newCompoundPayload(2, 3)),
+	})
+	test.fakePayload.On("OrderBy").Return([]specs.DriverOrder{
+		NewOrder().SetField(NewField().SetColumn("email")).SetDirection(directions.Desc),
+	})
+	test.fakePayload.On("Limit").Return(NewLimit().SetLimit(10))
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
+
+	query := "(SELECT `t0`.`id`, `t0`.`email` FROM `acceptance`.`users` AS `t0` WHERE `t0`.`id` = ?) " +
+		"UNION ALL (SELECT `t1`.`id` FROM `acceptance`.`users` AS `t1` WHERE `t1`.`id` = ?) " +
+		"EXCEPT (SELECT `t2`.`id` FROM `acceptance`.`users` AS `t2` WHERE `t2`.`id` = ?) " +
+		"ORDER BY 2 DESC LIMIT 0, 10"
+	test.fakeSqlIn.On("Execute", query, 1, 2, 3).Return(query, []any{1, 2, 3}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestSelectWithCompoundDatabase() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("tenant"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/tenant?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("id")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{NewWhere().SetFrom(NewField().SetColumn("id")).SetOperator(operators.Equal).SetTo(1)})
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return([]specs.DriverCompound{
+		NewCompound().SetOperator(compounds.Intersect).SetPayload(test.newCompoundPayload(1, 2)),
+	})
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
+
+	query := "(SELECT `t0`.`id` FROM `tenant`.`users` AS `t0` WHERE `t0`.`id` = ?) " +
+		"INTERSECT (SELECT `t1`.`id` FROM `tenant`.`users` AS `t1` WHERE `t1`.`id` = ?)"
+	test.fakeSqlIn.On("Execute", query, 1, 2).Return(query, []any{1, 2}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestCompoundOrderByErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("id")})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return([]specs.DriverCompound{NewCompound()})
+	test.fakePayload.On("OrderBy").Return([]specs.DriverOrder{NewOrder().SetField(NewField().SetColumn("email"))})
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.ErrorAs(err, new(specs.ErrCompoundOrder))
+	test.EqualError(err, "the field \"`t0`.`email`\" must be selected to order a compound query")
+}
+
+func (test *MysqlTestSuite) TestSelectWithGroupByHaving() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	total := NewField().SetCustom("COUNT(${field})", []specs.DriverField{NewField().SetColumn("id").SetName("field")})
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("user_id"), total})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("post_id")).SetOperator("=").SetTo(4),
+	})
+	test.fakePayload.On("Table").Return("comments")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("GroupBy").Return([]specs.DriverField{NewField().SetColumn("user_id")})
+	test.fakePayload.On("Having").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(total).SetOperator(">").SetTo(1),
+	})
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(nil)
+	test.fakePayload.On("Distinct").Return(false)
+	test.fakePayload.On("Mapping").Return([]any{}, nil)
+
+	query := "SELECT `t0`.`user_id`, (COUNT(`t0`.`id`)) FROM `acceptance`.`comments` AS `t0` WHERE `t0`.`post_id` = ? GROUP BY `t0`.`user_id` HAVING (COUNT(`t0`.`id`)) > ?"
+	test.fakeSqlIn.On("Execute", query, 4, 1).Return(query, []any{4, 1}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestGroupByErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+
+	test.fakeDriverField.On("Formatted").Return("", errors.New("group_by_formatted_err"))
+	test.fakePayload.On("GroupBy").Return([]specs.DriverField{test.fakeDriverField})
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "group_by_formatted_err")
+}
+
+func (test *MysqlTestSuite) TestHavingErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("GroupBy").Return(nil)
+
+	test.fakeDriverWhere.On("Formatted").Return("", nil, errors.New("having_formatted_err"))
+	test.fakePayload.On("Having").Return([]specs.DriverWhere{test.fakeDriverWhere})
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "having_formatted_err")
+}
+
+func (test *MysqlTestSuite) TestOrderByErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+
+	test.fakeDriverField.On("Formatted").Return("", errors.New("order_by_formatted_err"))
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return([]specs.DriverOrder{NewOrder().SetField(test.fakeDriverField)})
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.EqualError(err, "order_by_formatted_err")
+}
+
+func (test *MysqlTestSuite) TestLimitErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+
+	test.fakeDriverJoin.On("Validate").Return(nil)
+	test.fakeDriverJoin.On("Formatted").Return("", nil)
+
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{
+		test.fakeDriverJoin,
+	})
+
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(test.fakeDriverLimit)
+	test.fakeDriverLimit.On("Formatted").Return("", errors.New("select_limit_formatted_err"))
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.Error(err)
+	test.Contains(err.Error(), "select_limit_formatted_err")
+}
+
+func (test *MysqlTestSuite) TestLockErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("GroupBy").Return(nil)
+	test.fakePayload.On("Having").Return(nil)
+	test.fakePayload.On("Compounds").Return(nil)
+	test.fakePayload.On("OrderBy").Return(nil)
+	test.fakePayload.On("Limit").Return(nil)
+	test.fakePayload.On("Lock").Return(test.fakeDriverLock)
+	test.fakeDriverLock.On("Formatted").Return("", errors.New("select_lock_formatted_err"))
+
+	err = drv.Select(context.Background(), test.fakePayload)
+	test.Error(err)
+	test.Contains(err.Error(), "select_lock_formatted_err")
+}
+
+func (test *MysqlTestSuite) TestExecutor() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	fakeTx := fakesql.NewTx(test.T())
+	test.fakeConn.On("Begin").Return(fakeTx, nil).Once()
+	fakeTx.On("Rollback").Return(nil).Once()
+
+	mysql := drv.(*Mysql)
+	tx, err := mysql.Get().BeginTx(context.Background(), nil)
+	if !test.NoError(err) {
+		return
+	}
+
+	ctx := WithTx(context.Background(), mysql.Get(), tx)
+	test.Same(mysql.Get(), mysql.executor(context.Background()))
+	test.Same(tx, mysql.executor(ctx))
+
+	savepointCtx, name, ok := WithSavepoint(ctx, mysql.Get())
+	test.True(ok)
+	test.Equal("sp_1", name)
+	test.Same(tx, mysql.executor(savepointCtx))
+
+	_, name, _ = WithSavepoint(savepointCtx, mysql.Get())
+	test.Equal("sp_2", name)
+
+	_, _, ok = WithSavepoint(context.Background(), mysql.Get())
+	test.False(ok)
+
+	// The transaction of another pool is ignored.
+	other := new(Mysql)
+	test.NoError(other.New(config.New().SetDriver("test").SetDatabase("acceptance")))
+	test.Same(other.Get(), other.executor(ctx))
+
+	test.NoError(tx.Rollback())
+}
+
+func (test *MysqlTestSuite) TestInsert() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("test@test.com"),
+		NewWhere().SetFrom(NewField().SetColumn("validated")).SetTo(true),
+	})
+
+	query := "INSERT INTO `acceptance`.`users` (`email`, `validated`) VALUES (?, ?)"
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(2)
+	test.fakeStmt.On("Close").Return(nil)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("LastInsertId").Return(int64(4), nil).Once()
+	test.fakeStmt.On("Exec", []driver.Value{"test@test.com", true}).Return(fakeResult, nil).Once()
+
+	result, err := drv.Insert(context.Background(), test.fakePayload)
+	if !test.NoError(err) {
+		return
+	}
+
+	lastInsertId, err := result.LastInsertId()
+	test.NoError(err)
+	test.EqualValues(4, lastInsertId)
+}
+
+func (test *MysqlTestSuite) TestUpsert() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("test@test.com"),
+		NewWhere().SetFrom(NewField().SetColumn("validated")).SetTo(true),
+	})
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetColumn("validated")})
+	test.fakePayload.On("PrimaryKey").Return(NewField().SetColumn("id"))
+
+	query := "INSERT INTO `acceptance`.`users` (`email`, `validated`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `validated` = VALUES(`validated`), `id` = LAST_INSERT_ID(`id`)"
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(2)
+	test.fakeStmt.On("Close").Return(nil)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(2), nil).Once()
+	test.fakeStmt.On("Exec", []driver.Value{"test@test.com", true}).Return(fakeResult, nil).Once()
+
+	result, err := drv.Upsert(context.Background(), test.fakePayload)
+	if !test.NoError(err) {
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	test.NoError(err)
+	test.EqualValues(2, rowsAffected)
+}
+
+func (test *MysqlTestSuite) TestUpsertWithoutPrimaryKey() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("extra")
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("name")).SetTo("test"),
+	})
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("PrimaryKey").Return(nil)
+
+	query := "INSERT INTO `acceptance`.`extra` (`name`) VALUES (?)"
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(1)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeStmt.On("Exec", []driver.Value{"test"}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	_, err = drv.Upsert(context.Background(), test.fakePayload)
+	test.NoError(err)
+}
+
+func (test *MysqlTestSuite) TestInsertMany() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	defer func(maxPlaceholders int) {
+		mysqlMaxPlaceholders = maxPlaceholders
+	}(mysqlMaxPlaceholders)
+	mysqlMaxPlaceholders = 4
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	row := func(email string, validated bool) []specs.DriverWhere {
+		return []specs.DriverWhere{
+			NewWhere().SetFrom(NewField().SetColumn("email")).SetTo(email),
+			NewWhere().SetFrom(NewField().SetColumn("validated")).SetTo(validated),
+		}
+	}
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Rows").Return([][]specs.DriverWhere{
+		row("first@test.com", true),
+		row("second@test.com", false),
+		row("third@test.com", true),
+	})
+
+	test.expectAutoIncrement(1)
+
+	first := "INSERT INTO `acceptance`.`users` (`email`, `validated`) VALUES (?, ?), (?, ?)"
+	second := "INSERT INTO `acceptance`.`users` (`email`, `validated`) VALUES (?, ?)"
+	firstStmt := fakesql.NewFakeStmt(test.T())
+	test.fakeConn.On("Prepare", first).Return(firstStmt, nil).Once()
+	firstStmt.On("NumInput").Return(4)
+	firstStmt.On("Close").Return(nil)
+	firstStmt.On("Exec", []driver.Value{"first@test.com", true, "second@test.com", false}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	test.fakeConn.On("Prepare", second).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(2)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeStmt.On("Exec", []driver.Value{"third@test.com", true}).Return(fakesql.NewResult(test.T()), nil).Once()
+
+	results, err := drv.InsertMany(context.Background(), test.fakePayload)
+	test.NoError(err)
+	test.Len(results, 2)
+}
+
+func (test *MysqlTestSuite) TestInsertManyErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Rows").Return([][]specs.DriverWhere{
+		{NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("first@test.com")},
+	})
+
+	query := "INSERT INTO `acceptance`.`users` (`email`) VALUES (?)"
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("prepare_err")).Once()
+
+	results, err := drv.InsertMany(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+	test.Empty(results)
+}
+
+// expectAutoIncrement expects the auto_increment_increment of the session to be read, returning increment.
+func (test *MysqlTestSuite) expectAutoIncrement(increment int64) {
+	query := "SELECT @@SESSION.auto_increment_increment"
+	stmt := fakesql.NewFakeStmt(test.T())
+	rows := fakesql.NewFakeRows(test.T())
+	test.fakeConn.On("Prepare", query).Return(stmt, nil).Once()
+	stmt.On("NumInput").Return(0)
+	stmt.On("Close").Return(nil)
+	stmt.On("Query", []driver.Value{}).Return(rows, nil).Once()
+	rows.On("Columns").Return([]string{"@@SESSION.auto_increment_increment"})
+	rows.On("Close").Return(nil)
+	rows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = increment
+		return nil
+	}).Once()
+}
+
+func (test *MysqlTestSuite) TestInsertManyAutoIncrementErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Rows").Return([][]specs.DriverWhere{
+		{NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("first@test.com")},
+		{NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("second@test.com")},
+	})
+
+	test.expectAutoIncrement(2)
+
+	results, err := drv.InsertMany(context.Background(), test.fakePayload)
+
+	autoIncrementErr := new(specs.ErrAutoIncrement)
+	test.ErrorAs(err, autoIncrementErr)
+	test.EqualError(err, "the multi-row inserts require an auto_increment_increment of 1 to deduce the generated ids, got 2")
+	test.Empty(results)
+}
+
+func (test *MysqlTestSuite) TestInsertManyAutoIncrementQueryErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Rows").Return([][]specs.DriverWhere{
+		{NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("first@test.com")},
+		{NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("second@test.com")},
+	})
+
+	test.fakeConn.On("Prepare", "SELECT @@SESSION.auto_increment_increment").Return(nil, errors.New("prepare_err")).Once()
+
+	_, err = drv.InsertMany(context.Background(), test.fakePayload)
+	test.EqualError(err, "prepare_err")
+}
+
+func (test *MysqlTestSuite) TestSplitRows() {
+	row := func(value any) []specs.DriverWhere {
+		return []specs.DriverWhere{NewWhere().SetFrom(NewField().SetColumn("content")).SetTo(value)}
+	}
+
+	statements := (&Mysql{Config: config.New().SetMaxAllowedPacket(30)}).splitRows([][]specs.DriverWhere{
+		row("0123456789"),
+		row([]byte("0123456789")),
+		row(42),
+		row("01234567890123456789012345678901234567890"),
+	})
+
+	var sizes []int
+	for _, statement := range statements {
+		sizes = append(sizes, len(statement))
+	}
+	test.Equal([]int{2, 1, 1}, sizes)
+	test.Empty(new(Mysql).splitRows(nil))
+}
+
+func (test *MysqlTestSuite) TestInsertErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("email")).SetTo("test@test.com"),
+	})
+
+	query := "INSERT INTO `acceptance`.`users` (`email`) VALUES (?)"
+	test.fakeConn.On("Prepare", query).Return(nil, errors.New("insert_err")).Once()
+
+	_, err = drv.Insert(context.Background(), test.fakePayload)
+	test.EqualError(err, "insert_err")
+}
+
+func (test *MysqlTestSuite) TestUpdate() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("email")).SetOperator("=").SetTo("test@test.com"),
+		NewWhere().SetFrom(NewField().SetColumn("validated")).SetOperator("=").SetTo(true),
+	})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("id")).SetOperator("=").SetTo(1),
+	})
+
+	query := "UPDATE `acceptance`.`users` AS `t0` SET `t0`.`email` = ?, `t0`.`validated` = ? WHERE `t0`.`id` = ?"
+	test.fakeSqlIn.On("Execute", query, "test@test.com", true, 1).Return(query, []any{"test@test.com", true, 1}, nil)
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(3)
+	test.fakeStmt.On("Close").Return(nil)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(1), nil).Once()
+	test.fakeStmt.On("Exec", []driver.Value{"test@test.com", true, int64(1)}).Return(fakeResult, nil).Once()
+
+	result, err := drv.Update(context.Background(), test.fakePayload)
+	if !test.NoError(err) {
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	test.NoError(err)
+	test.EqualValues(1, rowsAffected)
+}
+
+func (test *MysqlTestSuite) TestUpdateSetErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverWhere.On("Formatted").Return("", nil, errors.New("build_set_err"))
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{test.fakeDriverWhere})
+
+	_, err = drv.Update(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_set_err")
+}
+
+func (test *MysqlTestSuite) TestUpdateWhereErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverWhere.On("Formatted").Return("", nil, errors.New("build_where_err"))
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{test.fakeDriverWhere})
+
+	_, err = drv.Update(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_where_err")
+}
+
+func (test *MysqlTestSuite) TestUpdateJoinErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverJoin.On("Validate").Return(errors.New("build_join_err"))
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{test.fakeDriverJoin})
+
+	_, err = drv.Update(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_join_err")
+}
+
+func (test *MysqlTestSuite) TestUpdateSqlInErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Table").Return("users")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Values").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetColumn("email")).SetOperator("=").SetTo("test@test.com"),
+	})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+
+	query := "UPDATE `acceptance`.`users` AS `t0` SET `t0`.`email` = ?"
+	test.fakeSqlIn.On("Execute", query, "test@test.com").Return("", nil, errors.New("sql_in_err"))
+
+	_, err = drv.Update(context.Background(), test.fakePayload)
+	test.EqualError(err, "sql_in_err")
+}
+
+func (test *MysqlTestSuite) TestDelete() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("comments")
+	test.fakePayload.On("Index").Return(0)
+	test.fakeDriverJoin.On("Validate").Return(nil)
+	test.fakeDriverJoin.On("Formatted").Return("INNER JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", nil)
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{
+		test.fakeDriverJoin,
+	})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("email")).SetOperator("=").SetTo("test@test.com"),
+	})
+
+	query := "DELETE `t0` FROM `acceptance`.`comments` AS `t0` INNER JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id` WHERE `t1`.`email` = ?"
+	test.fakeSqlIn.On("Execute", query, "test@test.com").Return(query, []any{"test@test.com"}, nil)
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(1)
+	test.fakeStmt.On("Close").Return(nil)
+
+	fakeResult := fakesql.NewResult(test.T())
+	fakeResult.On("RowsAffected").Return(int64(2), nil).Once()
+	test.fakeStmt.On("Exec", []driver.Value{"test@test.com"}).Return(fakeResult, nil).Once()
+
+	result, err := drv.Delete(context.Background(), test.fakePayload)
+	if !test.NoError(err) {
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	test.NoError(err)
+	test.EqualValues(2, rowsAffected)
+}
+
+func (test *MysqlTestSuite) TestDeleteWhereErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverWhere.On("Formatted").Return("", nil, errors.New("build_where_err"))
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{test.fakeDriverWhere})
+
+	_, err = drv.Delete(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_where_err")
+}
+
+func (test *MysqlTestSuite) TestDeleteJoinErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverJoin.On("Validate").Return(errors.New("build_join_err"))
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{test.fakeDriverJoin})
+
+	_, err = drv.Delete(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_join_err")
+}
+
+func (test *MysqlTestSuite) TestDeleteSqlInErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Table").Return("comments")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+
+	query := "DELETE `t0` FROM `acceptance`.`comments` AS `t0`"
+	test.fakeSqlIn.On("Execute", query).Return("", nil, errors.New("sql_in_err"))
+
+	_, err = drv.Delete(context.Background(), test.fakePayload)
+	test.EqualError(err, "sql_in_err")
+}
+
+func (test *MysqlTestSuite) TestCount() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("comments")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakeDriverJoin.On("Validate").Return(nil)
+	test.fakeDriverJoin.On("Formatted").Return("JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id`", nil)
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{
+		test.fakeDriverJoin,
+	})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("email")).SetOperator("=").SetTo("test@test.com"),
+	})
+
+	query := "SELECT COUNT(*) FROM `acceptance`.`comments` AS `t0` JOIN `acceptance`.`users` AS `t1` ON `t1`.`id` = `t0`.`user_id` WHERE `t1`.`email` = ?"
+	test.fakeSqlIn.On("Execute", query, "test@test.com").Return(query, []any{"test@test.com"}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(1)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeRows.On("Columns").Return([]string{"COUNT(*)"})
+	test.fakeRows.On("Close").Return(nil)
+	test.fakeRows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = int64(4)
+		return nil
+	}).Once()
+	test.fakeStmt.On("Query", []driver.Value{"test@test.com"}).Return(test.fakeRows, nil)
+
+	total, err := drv.Count(context.Background(), test.fakePayload)
+	test.NoError(err)
+	test.EqualValues(4, total)
+}
+
+func (test *MysqlTestSuite) TestCountWhereErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverWhere.On("Formatted").Return("", nil, errors.New("build_where_err"))
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{test.fakeDriverWhere})
+
+	_, err = drv.Count(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_where_err")
+}
+
+func (test *MysqlTestSuite) TestCountJoinErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriverJoin.On("Validate").Return(errors.New("build_join_err"))
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{test.fakeDriverJoin})
+
+	_, err = drv.Count(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_join_err")
+}
+
+func (test *MysqlTestSuite) TestCountSqlInErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Table").Return("comments")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Fields").Return([]specs.DriverField{})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+
+	query := "SELECT COUNT(*) FROM `acceptance`.`comments` AS `t0`"
+	test.fakeSqlIn.On("Execute", query).Return("", nil, errors.New("sql_in_err"))
+
+	_, err = drv.Count(context.Background(), test.fakePayload)
+	test.EqualError(err, "sql_in_err")
+}
+
+func (test *MysqlTestSuite) TestCountDistinct() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakeDriver.On("Open", ":@tcp(:3306)/acceptance?parseTime=true&loc=Local").Return(test.fakeConn, nil)
+
+	test.fakePayload.On("Table").Return("posts")
+	test.fakePayload.On("Index").Return(0)
+	test.fakePayload.On("Fields").Return([]specs.DriverField{NewField().SetIndex(0).SetColumn("id")})
+	test.fakeDriverJoin.On("Validate").Return(nil)
+	test.fakeDriverJoin.On("Formatted").Return("JOIN `acceptance`.`comments` AS `t1` ON `t1`.`post_id` = `t0`.`id`", nil)
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{
+		test.fakeDriverJoin,
+	})
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{
+		NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("content")).SetOperator("=").SetTo("test"),
+	})
+
+	query := "SELECT COUNT(DISTINCT `t0`.`id`) FROM `acceptance`.`posts` AS `t0` JOIN `acceptance`.`comments` AS `t1` ON `t1`.`post_id` = `t0`.`id` WHERE `t1`.`content` = ?"
+	test.fakeSqlIn.On("Execute", query, "test").Return(query, []any{"test"}, nil)
+
+	test.fakeConn.On("Prepare", query).Return(test.fakeStmt, nil).Once()
+	test.fakeStmt.On("NumInput").Return(1)
+	test.fakeStmt.On("Close").Return(nil)
+	test.fakeRows.On("Columns").Return([]string{"COUNT(DISTINCT `t0`.`id`)"})
+	test.fakeRows.On("Close").Return(nil)
+	test.fakeRows.On("Next", mock.Anything).Return(func(dest []driver.Value) error {
+		dest[0] = int64(2)
+		return nil
+	}).Once()
+	test.fakeStmt.On("Query", []driver.Value{"test"}).Return(test.fakeRows, nil)
+
+	total, err := drv.Count(context.Background(), test.fakePayload)
+	test.NoError(err)
+	test.EqualValues(2, total)
+}
+
+func (test *MysqlTestSuite) TestCountFieldsErr() {
+	drv, err := Get("test")
+	if !test.Empty(err) {
+		return
+	}
+
+	err = drv.New(config.New().SetDriver("test").SetDatabase("acceptance"))
+	if !test.Empty(err) {
+		return
+	}
+
+	test.fakePayload.On("Where").Return([]specs.DriverWhere{})
+	test.fakePayload.On("Join").Return([]specs.DriverJoin{})
+	test.fakeDriverField.On("Formatted").Return("", errors.New("build_fields_err"))
+	test.fakePayload.On("Fields").Return([]specs.DriverField{test.fakeDriverField})
+
+	_, err = drv.Count(context.Background(), test.fakePayload)
+	test.EqualError(err, "build_fields_err")
 }
 
 func TestMysqlTestSuite(t *testing.T) {
@@ -15,4 +15,10 @@ var (
 	GreaterOrEqual = ">="
 	Less           = "<"
 	LessOrEqual    = "<="
+	Exists         = "EXISTS"
+	NotExists      = "NOT EXISTS"
+
+	And = "AND"
+	Or  = "OR"
+	Not = "NOT"
 )
@@ -0,0 +1,43 @@
+package drivers
+
+import (
+	"fmt"
+	"github.com/kitstack/dbkit/connector/drivers/directions"
+	"github.com/kitstack/dbkit/specs"
+)
+
+type order struct {
+	field     specs.DriverField
+	direction specs.OrderDirection
+}
+
+func (o *order) Direction() string {
+	return directions.Direction[o.direction]
+}
+
+func (o *order) Field() specs.DriverField {
+	return o.field
+}
+
+func (o *order) SetDirection(direction specs.OrderDirection) specs.DriverOrder {
+	o.direction = direction
+	return o
+}
+
+func (o *order) SetField(field specs.DriverField) specs.DriverOrder {
+	o.field = field
+	return o
+}
+
+func (o *order) Formatted() (string, error) {
+	formatted, err := o.Field().Formatted()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s %s", formatted, o.Direction()), nil
+}
+
+func NewOrder() specs.DriverOrder {
+	return new(order)
+}
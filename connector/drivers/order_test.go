@@ -0,0 +1,48 @@
+package drivers
+
+import (
+	"errors"
+	"github.com/kitstack/dbkit/connector/drivers/directions"
+	"github.com/kitstack/dbkit/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type OrderTestSuite struct {
+	suite.Suite
+	fakeField *mocks.FakeDriverField
+}
+
+func (suite *OrderTestSuite) SetupTest() {
+	suite.fakeField = mocks.NewFakeDriverField(suite.T())
+}
+
+func (suite *OrderTestSuite) TestOrderDirectionDefault() {
+	order := NewOrder()
+
+	suite.Equal(directions.Direction[directions.Asc], order.Direction())
+}
+
+func (suite *OrderTestSuite) TestOrder() {
+	order := NewOrder().SetField(suite.fakeField).SetDirection(directions.Desc)
+
+	suite.Equal(suite.fakeField, order.Field())
+	suite.fakeField.On("Formatted").Return("`t1`.`email`", nil).Once()
+
+	formatted, err := order.Formatted()
+	suite.NoError(err)
+	suite.Equal("`t1`.`email` DESC", formatted)
+}
+
+func (suite *OrderTestSuite) TestOrderFormattedErr() {
+	order := NewOrder().SetField(suite.fakeField)
+
+	suite.fakeField.On("Formatted").Return("", errors.New("field_formatted_err")).Once()
+
+	_, err := order.Formatted()
+	suite.EqualError(err, "field_formatted_err")
+}
+
+func TestOrderTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderTestSuite))
+}
@@ -0,0 +1,87 @@
+package drivers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// query is a SELECT statement kept clause by clause until it is rendered, so that it can be combined with
+// other statements by a set operator before its ordering, its limit and its locking clause are appended.
+type query struct {
+	distinct bool
+	fields   string
+	from     string
+	join     string
+	where    string
+	groupBy  string
+	having   string
+	orderBy  string
+	limit    string
+	lock     string
+
+	args []any
+
+	compounds []compoundQuery
+}
+
+// compoundQuery is a statement combined with a query by a set operator.
+type compoundQuery struct {
+	operator string
+	query    *query
+}
+
+// combine appends the statement to the query with the set operator.
+func (q *query) combine(operator string, other *query) {
+	q.compounds = append(q.compounds, compoundQuery{operator: operator, query: other})
+}
+
+// String renders the statement, a combined query renders each statement in parentheses
+// followed by the ordering, the limit and the locking clause of the combined result.
+func (q *query) String() string {
+	var parts []string
+	if len(q.compounds) == 0 {
+		parts = append(parts, q.core())
+	} else {
+		parts = append(parts, fmt.Sprintf("(%s)", q.core()))
+		for _, compound := range q.compounds {
+			parts = append(parts, compound.operator, fmt.Sprintf("(%s)", compound.query))
+		}
+	}
+
+	for _, clause := range []string{q.orderBy, q.limit, q.lock} {
+		if clause != "" {
+			parts = append(parts, clause)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Args returns the arguments of the placeholders, in the order they are rendered.
+func (q *query) Args() []any {
+	var args []any
+	args = append(args, q.args...)
+	for _, compound := range q.compounds {
+		args = append(args, compound.query.Args()...)
+	}
+
+	return args
+}
+
+// core renders the statement without its ordering, its limit and its locking clause.
+func (q *query) core() string {
+	parts := []string{"SELECT"}
+	if q.distinct {
+		parts = append(parts, "DISTINCT")
+	}
+
+	parts = append(parts, q.fields, "FROM", q.from)
+
+	for _, clause := range []string{q.join, q.where, q.groupBy, q.having} {
+		if clause != "" {
+			parts = append(parts, clause)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,67 @@
+package drivers
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type QueryTestSuite struct {
+	suite.Suite
+}
+
+func (suite *QueryTestSuite) TestString() {
+	q := &query{
+		fields:  "`t0`.`id`",
+		from:    "`acceptance`.`users` AS `t0`",
+		where:   "WHERE `t0`.`id` > ?",
+		orderBy: "ORDER BY `t0`.`id` ASC",
+		limit:   "LIMIT 0, 1",
+		lock:    "FOR UPDATE",
+		args:    []any{1},
+	}
+
+	suite.Equal("SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` WHERE `t0`.`id` > ? ORDER BY `t0`.`id` ASC LIMIT 0, 1 FOR UPDATE", q.String())
+	suite.Equal([]any{1}, q.Args())
+}
+
+func (suite *QueryTestSuite) TestStringDistinct() {
+	q := &query{distinct: true, fields: "`t0`.`email`", from: "`acceptance`.`users` AS `t0`"}
+
+	suite.Equal("SELECT DISTINCT `t0`.`email` FROM `acceptance`.`users` AS `t0`", q.String())
+	suite.Nil(q.Args())
+}
+
+func (suite *QueryTestSuite) TestStringCompound() {
+	q := &query{
+		fields:  "`t0`.`id`",
+		from:    "`acceptance`.`users` AS `t0`",
+		where:   "WHERE `t0`.`id` = ?",
+		orderBy: "ORDER BY 1 DESC",
+		limit:   "LIMIT 0, 10",
+		args:    []any{1},
+	}
+	q.combine("UNION ALL", &query{
+		fields:  "`t1`.`id`",
+		from:    "`acceptance`.`users` AS `t1`",
+		where:   "WHERE `t1`.`id` = ?",
+		orderBy: "ORDER BY `t1`.`id` ASC",
+		limit:   "LIMIT 0, 1",
+		args:    []any{2},
+	})
+	q.combine("EXCEPT", &query{
+		fields: "`t1`.`id`",
+		from:   "`acceptance`.`users` AS `t1`",
+		where:  "WHERE `t1`.`id` = ?",
+		args:   []any{3},
+	})
+
+	suite.Equal("(SELECT `t0`.`id` FROM `acceptance`.`users` AS `t0` WHERE `t0`.`id` = ?) "+
+		"UNION ALL (SELECT `t1`.`id` FROM `acceptance`.`users` AS `t1` WHERE `t1`.`id` = ? ORDER BY `t1`.`id` ASC LIMIT 0, 1) "+
+		"EXCEPT (SELECT `t1`.`id` FROM `acceptance`.`users` AS `t1` WHERE `t1`.`id` = ?) "+
+		"ORDER BY 1 DESC LIMIT 0, 10", q.String())
+	suite.Equal([]any{1, 2, 3}, q.Args())
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
@@ -0,0 +1,42 @@
+package drivers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// txKey keys the transaction opened on a pool in a context, a context can carry the transactions of several connectors.
+type txKey struct {
+	db *sql.DB
+}
+
+// transaction is the value carried by a context, with the number of savepoints opened down to that context.
+type transaction struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
+// WithTx returns a copy of the context carrying the transaction opened on the pool.
+func WithTx(ctx context.Context, db *sql.DB, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{db: db}, transaction{tx: tx})
+}
+
+// WithSavepoint returns a copy of the context carrying one more savepoint of the transaction opened on the pool,
+// along with the name of that savepoint. It reports false when the context carries no transaction.
+func WithSavepoint(ctx context.Context, db *sql.DB) (context.Context, string, bool) {
+	current, ok := ctx.Value(txKey{db: db}).(transaction)
+	if !ok {
+		return ctx, "", false
+	}
+
+	current.savepoints++
+
+	return context.WithValue(ctx, txKey{db: db}, current), fmt.Sprintf("sp_%d", current.savepoints), true
+}
+
+// TxFromContext returns the transaction opened on the pool carried by the context, if any.
+func TxFromContext(ctx context.Context, db *sql.DB) (*sql.Tx, bool) {
+	current, ok := ctx.Value(txKey{db: db}).(transaction)
+	return current.tx, ok
+}
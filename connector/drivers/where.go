@@ -8,10 +8,18 @@ import (
 	"strings"
 )
 
+// selectFormatter renders the SELECT statement of a subquery, it is the driver running the query the where belongs to,
+// so the subquery shares its dialect and its database with the outer query.
+type selectFormatter interface {
+	Database() string
+	buildSelect(payload specs.Payload, database string) (string, []any, error)
+}
+
 type where struct {
 	from     specs.DriverField
 	operator string
 	to       any
+	wheres   []specs.DriverWhere
 }
 
 func (w *where) From() specs.DriverField {
@@ -26,6 +34,19 @@ func (w *where) To() any {
 	return w.to
 }
 
+func (w *where) Wheres() []specs.DriverWhere {
+	return w.wheres
+}
+
+// IsGroup reports whether the where combines nested wheres (AND, OR, NOT) instead of comparing a field.
+func (w *where) IsGroup() bool {
+	switch w.Operator() {
+	case operators.And, operators.Or, operators.Not:
+		return true
+	}
+	return false
+}
+
 func (w *where) SetFrom(from specs.DriverField) specs.DriverWhere {
 	w.from = from
 	return w
@@ -41,6 +62,41 @@ func (w *where) SetTo(to any) specs.DriverWhere {
 	return w
 }
 
+func (w *where) SetWheres(wheres ...specs.DriverWhere) specs.DriverWhere {
+	w.wheres = wheres
+	return w
+}
+
+func (w *where) groupFormatted(driver selectFormatter) (string, []any, error) {
+	if len(w.Wheres()) == 0 {
+		return "", nil, NewEmptyGroupErr(w.Operator())
+	}
+
+	separator := operators.And
+	if w.Operator() == operators.Or {
+		separator = operators.Or
+	}
+
+	var parts []string
+	var args []any
+	for _, current := range w.Wheres() {
+		formatted, currentArgs, err := formatWhere(current, driver)
+		if err != nil {
+			return "", nil, err
+		}
+
+		parts = append(parts, formatted)
+		args = append(args, currentArgs...)
+	}
+
+	result := fmt.Sprintf("(%s)", strings.Join(parts, fmt.Sprintf(" %s ", separator)))
+	if w.Operator() == operators.Not {
+		result = fmt.Sprintf("%s %s", operators.Not, result)
+	}
+
+	return result, args, nil
+}
+
 func (w *where) buildOperator() (string, bool, error) {
 	switch w.Operator() {
 	case operators.Equal, operators.NotEqual, operators.Like, operators.NotLike, operators.Greater, operators.GreaterOrEqual, operators.Less, operators.LessOrEqual:
@@ -55,7 +111,56 @@ func (w *where) buildOperator() (string, bool, error) {
 	return "", false, NewUnknownOperatorErr(w.Operator())
 }
 
+// subqueryFormatted renders the payload given as value inline, its args are merged in place,
+// EXISTS and NOT EXISTS have no field on the left side.
+func (w *where) subqueryFormatted(driver selectFormatter, subquery specs.Payload) (string, []any, error) {
+	if driver == nil {
+		return "", nil, NewSubqueryDriverErr(w.Operator())
+	}
+
+	query, args, err := driver.buildSelect(subquery, driver.Database())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if w.Operator() == operators.Exists || w.Operator() == operators.NotExists {
+		return fmt.Sprintf("%s (%s)", w.Operator(), query), args, nil
+	}
+
+	operator, flat, err := w.buildOperator()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if flat || w.Operator() == operators.IsNull || w.Operator() == operators.IsNotNull {
+		return "", nil, NewUnknownOperatorErr(w.Operator())
+	}
+
+	from, err := w.From().Formatted()
+	if err != nil {
+		return "", nil, err
+	}
+
+	operator = strings.Replace(operator, "(?)", "?", 1)
+
+	return fmt.Sprintf("%s %s", from, strings.Replace(operator, "?", fmt.Sprintf("(%s)", query), 1)), args, nil
+}
+
+// Formatted renders the where on its own, a where holding a subquery must be rendered by the driver running the query.
 func (w *where) Formatted() (string, []any, error) {
+	return w.formattedWith(nil)
+}
+
+// formattedWith renders the where, its subqueries and the ones of its nested wheres are rendered by the driver given.
+func (w *where) formattedWith(driver selectFormatter) (string, []any, error) {
+	if w.IsGroup() {
+		return w.groupFormatted(driver)
+	}
+
+	if subquery, ok := w.To().(specs.Payload); ok {
+		return w.subqueryFormatted(driver, subquery)
+	}
+
 	operator, flat, err := w.buildOperator()
 	if err != nil {
 		return "", nil, err
@@ -66,6 +171,10 @@ func (w *where) Formatted() (string, []any, error) {
 		return "", nil, err
 	}
 
+	if w.Operator() == operators.IsNull || w.Operator() == operators.IsNotNull {
+		return fmt.Sprintf("%s %s", from, operator), nil, nil
+	}
+
 	drvField, ok := w.To().(specs.DriverField)
 	if ok {
 		to, err := drvField.Formatted()
@@ -93,3 +202,12 @@ func (w *where) Formatted() (string, []any, error) {
 func NewWhere() specs.DriverWhere {
 	return new(where)
 }
+
+// formatWhere renders a where with the driver given, the wheres implemented outside of the package are rendered on their own.
+func formatWhere(current specs.DriverWhere, driver selectFormatter) (string, []any, error) {
+	if current, ok := current.(*where); ok {
+		return current.formattedWith(driver)
+	}
+
+	return current.Formatted()
+}
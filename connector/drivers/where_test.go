@@ -2,7 +2,9 @@ package drivers
 
 import (
 	"errors"
+	"github.com/kitstack/dbkit/connector/config"
 	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/dbkit/tests/mocks"
 	"github.com/stretchr/testify/suite"
 	"testing"
@@ -11,10 +13,12 @@ import (
 type WhereTestSuite struct {
 	suite.Suite
 	fakeDriverField *mocks.FakeDriverField
+	driver          *Mysql
 }
 
 func (test *WhereTestSuite) SetupTest() {
 	test.fakeDriverField = mocks.NewFakeDriverField(test.T())
+	test.driver = &Mysql{Config: config.New().SetDatabase("acceptance")}
 }
 
 func (test *WhereTestSuite) TestWhereOperator() {
@@ -203,6 +207,120 @@ func (test *WhereTestSuite) TestWhereFormattedWithTwoField() {
 	test.Equal([]any(nil), args)
 }
 
+func (test *WhereTestSuite) TestWhereIsNullFormatted() {
+	formatted, args, err := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("parent_id")).SetOperator(operators.IsNull).Formatted()
+	test.NoError(err)
+	test.Equal("`t0`.`parent_id` IS NULL", formatted)
+	test.Nil(args)
+}
+
+func (test *WhereTestSuite) TestWhereGroupFormatted() {
+	title := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("title")).SetOperator(operators.Like).SetTo("%go%")
+	content := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("content")).SetOperator(operators.Like).SetTo("%go%")
+	author := NewWhere().SetFrom(NewField().SetIndex(1).SetColumn("id")).SetOperator(operators.Equal).SetTo(1)
+	parent := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("parent_id")).SetOperator(operators.IsNull)
+
+	where := NewWhere().SetOperator(operators.Or).SetWheres(
+		title,
+		NewWhere().SetOperator(operators.And).SetWheres(content, NewWhere().SetOperator(operators.Not).SetWheres(author, parent)),
+	)
+
+	test.True(where.IsGroup())
+	test.Len(where.Wheres(), 2)
+
+	formatted, args, err := where.Formatted()
+	test.NoError(err)
+	test.Equal("(`t0`.`title` LIKE ? OR (`t0`.`content` LIKE ? AND NOT (`t1`.`id` = ? AND `t0`.`parent_id` IS NULL)))", formatted)
+	test.Equal([]any{"%go%", "%go%", 1}, args)
+}
+
+func (test *WhereTestSuite) TestWhereEmptyGroupErr() {
+	_, _, err := NewWhere().SetOperator(operators.Or).Formatted()
+
+	var emptyGroupErr specs.ErrEmptyGroup
+	test.True(errors.As(err, &emptyGroupErr))
+	test.Equal(operators.Or, emptyGroupErr.Operator())
+	test.EqualError(err, "the group \"OR\" requires one or more conditions")
+}
+
+func (test *WhereTestSuite) TestWhereGroupFormattedErr() {
+	test.fakeDriverField.On("Formatted").Return("", errors.New("from_formatted_err")).Once()
+
+	_, _, err := NewWhere().SetOperator(operators.And).SetWheres(
+		NewWhere().SetFrom(test.fakeDriverField).SetOperator(operators.Equal).SetTo(1),
+	).Formatted()
+	test.EqualError(err, "from_formatted_err")
+}
+
+func (test *WhereTestSuite) newSubquery(where specs.DriverWhere) *mocks.FakePayload {
+	payload := mocks.NewFakePayload(test.T())
+	payload.On("Fields").Return([]specs.DriverField{NewField().SetIndex(3).SetColumn("post_id")}).Maybe()
+	payload.On("Where").Return([]specs.DriverWhere{where}).Maybe()
+	payload.On("Join").Return([]specs.DriverJoin{}).Maybe()
+	payload.On("GroupBy").Return([]specs.DriverField{}).Maybe()
+	payload.On("Having").Return([]specs.DriverWhere{}).Maybe()
+	payload.On("OrderBy").Return([]specs.DriverOrder{}).Maybe()
+	payload.On("Limit").Return(nil).Maybe()
+	payload.On("Lock").Return(nil).Maybe()
+	payload.On("Distinct").Return(false).Maybe()
+	payload.On("Compounds").Return(nil).Maybe()
+	payload.On("Database").Return("model_database").Maybe()
+	payload.On("Table").Return("comments").Maybe()
+	payload.On("Index").Return(3).Maybe()
+
+	return payload
+}
+
+func (test *WhereTestSuite) TestWhereSubqueryFormatted() {
+	subquery := test.newSubquery(NewWhere().SetFrom(NewField().SetIndex(3).SetColumn("user_id")).SetOperator(operators.In).SetTo([]int{2, 3}))
+
+	formatted, args, err := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("id")).SetOperator(operators.NotIn).SetTo(subquery).(*where).formattedWith(test.driver)
+	test.NoError(err)
+	test.Equal("`t0`.`id` NOT IN (SELECT `t3`.`post_id` FROM `acceptance`.`comments` AS `t3` WHERE `t3`.`user_id` IN (?))", formatted)
+	test.Equal([]any{[]int{2, 3}}, args)
+}
+
+func (test *WhereTestSuite) TestWhereExistsFormatted() {
+	subquery := test.newSubquery(NewWhere().SetFrom(NewField().SetIndex(3).SetColumn("post_id")).SetOperator(operators.Equal).SetTo(NewField().SetIndex(0).SetColumn("id")))
+
+	formatted, args, err := NewWhere().SetOperator(operators.NotExists).SetTo(subquery).(*where).formattedWith(test.driver)
+	test.NoError(err)
+	test.Equal("NOT EXISTS (SELECT `t3`.`post_id` FROM `acceptance`.`comments` AS `t3` WHERE `t3`.`post_id` = `t0`.`id`)", formatted)
+	test.Nil(args)
+}
+
+func (test *WhereTestSuite) TestWhereSubqueryOperatorErr() {
+	subquery := test.newSubquery(NewWhere().SetFrom(NewField().SetIndex(3).SetColumn("user_id")).SetOperator(operators.Equal).SetTo(2))
+
+	_, _, err := NewWhere().SetFrom(NewField().SetIndex(0).SetColumn("id")).SetOperator(operators.Between).SetTo(subquery).(*where).formattedWith(test.driver)
+	test.EqualError(err, "unknown operator: BETWEEN")
+}
+
+func (test *WhereTestSuite) TestWhereSubqueryErr() {
+	subquery := test.newSubquery(NewWhere().SetOperator(operators.Or))
+
+	_, _, err := NewWhere().SetOperator(operators.Exists).SetTo(subquery).(*where).formattedWith(test.driver)
+	test.EqualError(err, "the group \"OR\" requires one or more conditions")
+}
+
+func (test *WhereTestSuite) TestWhereSubqueryNestedFormatted() {
+	subquery := test.newSubquery(NewWhere().SetFrom(NewField().SetIndex(3).SetColumn("user_id")).SetOperator(operators.Equal).SetTo(2))
+
+	formatted, args, err := NewWhere().SetOperator(operators.Not).SetWheres(
+		NewWhere().SetOperator(operators.Exists).SetTo(subquery),
+	).(*where).formattedWith(test.driver)
+	test.NoError(err)
+	test.Equal("NOT (EXISTS (SELECT `t3`.`post_id` FROM `acceptance`.`comments` AS `t3` WHERE `t3`.`user_id` = ?))", formatted)
+	test.Equal([]any{2}, args)
+}
+
+func (test *WhereTestSuite) TestWhereSubqueryDriverErr() {
+	subquery := test.newSubquery(NewWhere().SetFrom(NewField().SetIndex(3).SetColumn("user_id")).SetOperator(operators.Equal).SetTo(2))
+
+	_, _, err := NewWhere().SetOperator(operators.Exists).SetTo(subquery).Formatted()
+	test.EqualError(err, "the subquery of the operator \"EXISTS\" must be rendered by the driver running the query")
+}
+
 func TestWhereTestSuite(t *testing.T) {
 	suite.Run(t, new(WhereTestSuite))
 }
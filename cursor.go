@@ -0,0 +1,83 @@
+package dbkit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/specs"
+	"reflect"
+	"strings"
+)
+
+// cursor is the position of a row in a keyset pagination, it holds the value of each key of the row
+// and whether the page is read backward from it.
+type cursor struct {
+	Values   []json.RawMessage `json:"v"`
+	Backward bool              `json:"b"`
+}
+
+// encodeCursor returns the opaque cursor of the row, from the value of each of the keys.
+func encodeCursor(keys []specs.FieldDefinition, backward bool) (string, error) {
+	current := cursor{Backward: backward}
+	for _, key := range keys {
+		value, err := json.Marshal(key.Get())
+		if err != nil {
+			return "", err
+		}
+
+		current.Values = append(current.Values, value)
+	}
+
+	encoded, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodeCursor returns the values of the keys held by the cursor, typed after the fields of the keys.
+func decodeCursor(encoded string, keys []specs.FieldDefinition) (values []any, backward bool, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false, NewInvalidCursorError(encoded)
+	}
+
+	var current cursor
+	if err := json.Unmarshal(decoded, &current); err != nil || len(current.Values) != len(keys) {
+		return nil, false, NewInvalidCursorError(encoded)
+	}
+
+	for i, key := range keys {
+		value := reflect.New(key.Value().Type())
+		if err := json.Unmarshal(current.Values[i], value.Interface()); err != nil {
+			return nil, false, NewInvalidCursorError(encoded)
+		}
+
+		values = append(values, value.Elem().Interface())
+	}
+
+	return values, current.Backward, nil
+}
+
+// newKeysetCondition matches the rows placed after the values of the keys in their order, or before them backward,
+// e.g. `(a > ?) OR (a = ? AND b > ?)` for two ascending keys.
+func newKeysetCondition(orders []string, values []any, backward bool) specs.Condition {
+	var conditions []specs.Condition
+	for i, order := range orders {
+		var current []specs.Condition
+		for j := 0; j < i; j++ {
+			current = append(current, NewCondition().SetFrom(strings.TrimLeft(orders[j], "+-")).SetOperator(operators.Equal).SetTo(values[j]))
+		}
+
+		operator := operators.Greater
+		if strings.HasPrefix(order, "-") != backward {
+			operator = operators.Less
+		}
+
+		current = append(current, NewCondition().SetFrom(strings.TrimLeft(order, "+-")).SetOperator(operator).SetTo(values[i]))
+		conditions = append(conditions, And(current...))
+	}
+
+	return Or(conditions...)
+}
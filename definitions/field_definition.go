@@ -3,6 +3,7 @@ package definitions
 import (
 	"fmt"
 	"github.com/kitstack/dbkit/connector/drivers"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
 	"github.com/kitstack/dbkit/specs"
 	"reflect"
 	"strings"
@@ -30,16 +31,22 @@ type fieldDefinition struct {
 	visitedMap map[string]bool
 }
 
+// Join returns the joins required to reach the field, ordered from the root model so each alias is declared before use.
 func (field *fieldDefinition) Join() (joins []specs.DriverJoin) {
 	if field.Model().FromField() != nil {
+		joins = append(joins, field.Model().FromField().Join()...)
 		if !field.IsSlice() {
 			join := drivers.NewJoin().
 				SetFrom(drivers.NewField().SetIndex(field.Model().FromField().Model().Index()).SetTable(field.Model().FromField().Model().TableName()).SetColumn(field.Model().FromField().Tags()["column"]).SetDatabase(field.Model().FromField().Model().DatabaseName())).
 				SetTo(drivers.NewField().SetIndex(field.Model().Index()).SetTable(field.Model().TableName()).SetColumn(field.Model().FromField().Tags()["foreignKey"]).SetDatabase(field.Model().DatabaseName()))
 
+			// The soft deleted rows of the joined model are excluded in the ON clause.
+			if softDelete := field.Model().GetSoftDeleteField(); softDelete != nil {
+				join.SetWheres(drivers.NewWhere().SetFrom(softDelete.Field()).SetOperator(operators.IsNull))
+			}
+
 			joins = append(joins, join)
 		}
-		joins = append(joins, field.Model().FromField().Join()...)
 		return
 	}
 	return
@@ -167,9 +174,13 @@ func (md *modelDefinition) parseField(index int) specs.FieldDefinition {
 }
 
 func (field *fieldDefinition) ParseTags() {
-	field.tags = make(map[string]string)
 	// TODO (kitstack) : add support to client choice of tag name
-	tags := field.tag.Get("dbKit")
+	field.tags = ParseTag(field.tag.Get("dbKit"))
+}
+
+// ParseTag parses the content of a `dbKit` tag (e.g. `column:id, primaryKey`) into its options.
+func ParseTag(tags string) map[string]string {
+	result := make(map[string]string)
 
 	for _, tag := range strings.Split(tags, ",") {
 		tag = strings.TrimSpace(tag)
@@ -183,8 +194,10 @@ func (field *fieldDefinition) ParseTags() {
 		if len(tagParts) == 1 {
 			tagParts = append(tagParts, "true")
 		}
-		field.tags[tagParts[0]] = tagParts[1]
+		result[tagParts[0]] = tagParts[1]
 	}
+
+	return result
 }
 
 func (field *fieldDefinition) IsVisited() bool {
@@ -220,6 +233,22 @@ func (field *fieldDefinition) IsPrimaryKey() bool {
 	return field.tags["primaryKey"] == "true"
 }
 
+func (field *fieldDefinition) IsSoftDelete() bool {
+	return field.tags["softDelete"] == "true"
+}
+
+func (field *fieldDefinition) IsVersion() bool {
+	return field.tags["version"] == "true"
+}
+
+func (field *fieldDefinition) IsAutoCreateTime() bool {
+	return field.tags["autoCreateTime"] == "true"
+}
+
+func (field *fieldDefinition) IsAutoUpdateTime() bool {
+	return field.tags["autoUpdateTime"] == "true"
+}
+
 func (field *fieldDefinition) Field() specs.DriverField {
 	return drivers.NewField().SetColumn(field.Column()).SetIndex(field.Index()).SetName(field.RecursiveFullName())
 }
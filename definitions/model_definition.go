@@ -87,6 +87,38 @@ func (md *modelDefinition) GetPrimaryField() (specs.FieldDefinition, specs.ErrPr
 	return nil, NewErrNoPrimaryField(md)
 }
 
+// GetSoftDeleteField returns the field tagged `softDelete` on the model itself, or nil when the model is not soft deletable.
+func (md *modelDefinition) GetSoftDeleteField() specs.FieldDefinition {
+	for _, field := range md.fields {
+
+		if field.Model() != md {
+			continue
+		}
+
+		if field.IsSoftDelete() {
+			return field
+		}
+
+	}
+	return nil
+}
+
+// GetVersionField returns the field tagged `version` on the model itself, or nil when the model is not versioned.
+func (md *modelDefinition) GetVersionField() specs.FieldDefinition {
+	for _, field := range md.fields {
+
+		if field.Model() != md {
+			continue
+		}
+
+		if field.IsVersion() {
+			return field
+		}
+
+	}
+	return nil
+}
+
 func (md *modelDefinition) GetFieldByColumn(column string) (specs.FieldDefinition, specs.ErrFieldNoFoundByColumn) {
 	for _, field := range md.fields {
 
@@ -94,7 +94,7 @@ func (test *SchemaTestSuite) TestComplexeModel() {
 	modelDefinition := Use(&models.CommentsModel{}).Parse()
 	test.Equal("comments", modelDefinition.TableName())
 	test.Equal("acceptance", modelDefinition.DatabaseName())
-	test.Equal(93, len(modelDefinition.Fields()))
+	test.Equal(96, len(modelDefinition.Fields()))
 }
 
 func (test *SchemaTestSuite) TestParseNilPtr() {
@@ -198,6 +198,20 @@ func (test *SchemaTestSuite) TestJoin() {
 			SetFrom(drivers.NewField().SetIndex(0).SetColumn("post_id").SetTable("comments").SetDatabase("acceptance")).
 			SetTo(drivers.NewField().SetIndex(2).SetColumn("id").SetTable("posts").SetDatabase("acceptance")),
 	})
+
+	postCreatorIdFieldDefinition, err := schemaTest.GetFieldByName("Post.Creator.Id")
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal(postCreatorIdFieldDefinition.Join(), []specs.DriverJoin{
+		drivers.NewJoin().
+			SetFrom(drivers.NewField().SetIndex(0).SetColumn("post_id").SetTable("comments").SetDatabase("acceptance")).
+			SetTo(drivers.NewField().SetIndex(2).SetColumn("id").SetTable("posts").SetDatabase("acceptance")),
+		drivers.NewJoin().
+			SetFrom(drivers.NewField().SetIndex(2).SetColumn("c_user_id").SetTable("posts").SetDatabase("acceptance")).
+			SetTo(drivers.NewField().SetIndex(3).SetColumn("id").SetTable("users").SetDatabase("acceptance")),
+	})
 }
 
 func (test *SchemaTestSuite) TestGetFieldByName() {
@@ -236,6 +250,41 @@ func (test *SchemaTestSuite) TestGetPrimaryField() {
 	test.ErrorContains(err, "no primary field found in model `DebugModel`")
 }
 
+func (test *SchemaTestSuite) TestGetSoftDeleteField() {
+	schemaTest := Use(&models.NotesModel{}).Parse()
+
+	deletedFieldDefinition, err := schemaTest.GetFieldByName("Deleted")
+	if !test.NoError(err) {
+		return
+	}
+
+	test.True(deletedFieldDefinition.IsSoftDelete())
+	test.Equal(deletedFieldDefinition, schemaTest.GetSoftDeleteField())
+
+	parentFieldDefinition, err := schemaTest.GetFieldByName("Parent.Content")
+	if !test.NoError(err) {
+		return
+	}
+
+	test.Equal("Parent.Deleted", parentFieldDefinition.Model().GetSoftDeleteField().RecursiveFullName())
+
+	test.Nil(Use(&models.UsersModel{}).Parse().GetSoftDeleteField())
+}
+
+func (test *SchemaTestSuite) TestAutoTimeFields() {
+	schemaTest := Use(&models.CommentsModel{}).Parse()
+
+	for name, expected := range map[string][2]bool{"Created": {true, false}, "Updated": {false, true}, "Content": {false, false}} {
+		field, err := schemaTest.GetFieldByName(name)
+		if !test.NoError(err) {
+			return
+		}
+
+		test.Equal(expected[0], field.IsAutoCreateTime(), name)
+		test.Equal(expected[1], field.IsAutoUpdateTime(), name)
+	}
+}
+
 func (test *SchemaTestSuite) TestGetToColumn() {
 	schemaTest := Use(&models.CommentsModel{}).Parse()
 
@@ -29,3 +29,257 @@ func NewNotFoundError(model string) *NotFoundError {
 		model: model,
 	}
 }
+
+type PrimaryKeyRequiredError struct {
+	queryType string
+	model     string
+}
+
+func (e *PrimaryKeyRequiredError) Error() string {
+	return fmt.Sprintf("the method `%s` requires a non-zero primary key for %s", e.queryType, e.model)
+}
+
+func NewPrimaryKeyRequiredError(queryType string, model string) *PrimaryKeyRequiredError {
+	return &PrimaryKeyRequiredError{
+		queryType: queryType,
+		model:     model,
+	}
+}
+
+type FieldNotWritableError struct {
+	queryType string
+	field     string
+}
+
+func (e *FieldNotWritableError) Error() string {
+	return fmt.Sprintf("the method `%s` can not write the field `%s`, only the columns of the model itself are writable", e.queryType, e.field)
+}
+
+func NewFieldNotWritableError(queryType string, field string) *FieldNotWritableError {
+	return &FieldNotWritableError{
+		queryType: queryType,
+		field:     field,
+	}
+}
+
+type ConditionRequiredError struct {
+	queryType string
+}
+
+func (e *ConditionRequiredError) Error() string {
+	return fmt.Sprintf("the method `%s` requires one or more conditions, use AllowUnconditional to affect every row", e.queryType)
+}
+
+func NewConditionRequiredError(queryType string) *ConditionRequiredError {
+	return &ConditionRequiredError{
+		queryType: queryType,
+	}
+}
+
+type FieldNotSortableError struct {
+	queryType string
+	field     string
+}
+
+func (e *FieldNotSortableError) Error() string {
+	return fmt.Sprintf("the method `%s` can not sort by the field `%s`, it belongs to a slice relation", e.queryType, e.field)
+}
+
+func NewFieldNotSortableError(queryType string, field string) *FieldNotSortableError {
+	return &FieldNotSortableError{
+		queryType: queryType,
+		field:     field,
+	}
+}
+
+type FieldNotSelectableError struct {
+	queryType string
+	field     string
+}
+
+func (e *FieldNotSelectableError) Error() string {
+	return fmt.Sprintf("the method `%s` can not select the field `%s`, it belongs to a slice relation", e.queryType, e.field)
+}
+
+func NewFieldNotSelectableError(queryType string, field string) *FieldNotSelectableError {
+	return &FieldNotSelectableError{
+		queryType: queryType,
+		field:     field,
+	}
+}
+
+type SubqueryRequiredError struct {
+	queryType string
+	operator  string
+}
+
+func (e *SubqueryRequiredError) Error() string {
+	return fmt.Sprintf("the method `%s` requires a builder as the value of the operator `%s`", e.queryType, e.operator)
+}
+
+func NewSubqueryRequiredError(queryType string, operator string) *SubqueryRequiredError {
+	return &SubqueryRequiredError{
+		queryType: queryType,
+		operator:  operator,
+	}
+}
+
+type RelationMismatchError struct {
+	queryType string
+	path      string
+	model     string
+}
+
+func (e *RelationMismatchError) Error() string {
+	return fmt.Sprintf("the method `%s` can not correlate the subquery on `%s`, it is not a relation to %s", e.queryType, e.path, e.model)
+}
+
+func NewRelationMismatchError(queryType string, path string, model string) *RelationMismatchError {
+	return &RelationMismatchError{
+		queryType: queryType,
+		path:      path,
+		model:     model,
+	}
+}
+
+type InvalidCursorError struct {
+	cursor string
+}
+
+func (e *InvalidCursorError) Error() string {
+	return fmt.Sprintf("the cursor `%s` is invalid for this query", e.cursor)
+}
+
+func NewInvalidCursorError(cursor string) *InvalidCursorError {
+	return &InvalidCursorError{
+		cursor: cursor,
+	}
+}
+
+type PageSizeError struct {
+	queryType string
+	size      int
+}
+
+func (e *PageSizeError) Error() string {
+	return fmt.Sprintf("the method `%s` requires a page size of at least 1, got %d", e.queryType, e.size)
+}
+
+func NewPageSizeError(queryType string, size int) *PageSizeError {
+	return &PageSizeError{
+		queryType: queryType,
+		size:      size,
+	}
+}
+
+type NullableKeyError struct {
+	queryType string
+	field     string
+}
+
+func (e *NullableKeyError) Error() string {
+	return fmt.Sprintf("the method `%s` can not paginate on the field `%s`, it is nullable", e.queryType, e.field)
+}
+
+func NewNullableKeyError(queryType string, field string) *NullableKeyError {
+	return &NullableKeyError{
+		queryType: queryType,
+		field:     field,
+	}
+}
+
+type BatchSizeError struct {
+	queryType string
+	size      int
+}
+
+func (e *BatchSizeError) Error() string {
+	return fmt.Sprintf("the method `%s` requires a batch size of at least 1, got %d", e.queryType, e.size)
+}
+
+func NewBatchSizeError(queryType string, size int) *BatchSizeError {
+	return &BatchSizeError{
+		queryType: queryType,
+		size:      size,
+	}
+}
+
+// ErrStaleObject is returned by Update when the version of the model no longer matches the row,
+// the row has been updated by someone else since the model was read.
+type ErrStaleObject struct {
+	model   string
+	version any
+}
+
+func (e *ErrStaleObject) Error() string {
+	return fmt.Sprintf("the %s at version %v is stale, it has been updated or removed since it was read", e.model, e.version)
+}
+
+func NewErrStaleObject(model string, version any) *ErrStaleObject {
+	return &ErrStaleObject{
+		model:   model,
+		version: version,
+	}
+}
+
+type TransactionRequiredError struct {
+	queryType string
+}
+
+func (e *TransactionRequiredError) Error() string {
+	return fmt.Sprintf("the method `%s` requires a transaction in the context to lock the rows", e.queryType)
+}
+
+func NewTransactionRequiredError(queryType string) *TransactionRequiredError {
+	return &TransactionRequiredError{
+		queryType: queryType,
+	}
+}
+
+type LockCompoundError struct {
+	queryType string
+	operator  string
+}
+
+func (e *LockCompoundError) Error() string {
+	return fmt.Sprintf("the method `%s` can not lock the rows of a query combined by `%s`", e.queryType, e.operator)
+}
+
+func NewLockCompoundError(queryType string, operator string) *LockCompoundError {
+	return &LockCompoundError{
+		queryType: queryType,
+		operator:  operator,
+	}
+}
+
+type VersionFieldError struct {
+	queryType string
+	field     string
+}
+
+func (e *VersionFieldError) Error() string {
+	return fmt.Sprintf("the method `%s` requires the version field `%s` to be an integer", e.queryType, e.field)
+}
+
+func NewVersionFieldError(queryType string, field string) *VersionFieldError {
+	return &VersionFieldError{
+		queryType: queryType,
+		field:     field,
+	}
+}
+
+type ClauseNotSupportedError struct {
+	queryType string
+	clause    string
+}
+
+func (e *ClauseNotSupportedError) Error() string {
+	return fmt.Sprintf("the method `%s` does not support the `%s` clause", e.queryType, e.clause)
+}
+
+func NewClauseNotSupportedError(queryType string, clause string) *ClauseNotSupportedError {
+	return &ClauseNotSupportedError{
+		queryType: queryType,
+		clause:    clause,
+	}
+}
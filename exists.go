@@ -0,0 +1,25 @@
+package dbkit
+
+import "github.com/kitstack/dbkit/specs"
+
+// exists is the payload of Exists, it selects `1` and only records whether a row has been scanned.
+type exists struct {
+	specs.Payload
+
+	found bool
+}
+
+func (e *exists) Mapping() ([]any, error) {
+	return []any{new(int)}, nil
+}
+
+func (e *exists) OnScan([]any) error {
+	e.found = true
+	return nil
+}
+
+func newExists(payload specs.Payload) *exists {
+	return &exists{
+		Payload: payload,
+	}
+}
@@ -0,0 +1,32 @@
+package dbkit
+
+import "github.com/kitstack/dbkit/specs"
+
+type page[T specs.Model] struct {
+	items    []T
+	next     string
+	previous string
+}
+
+// Items returns the rows of the page, in the order of the keys.
+func (p *page[T]) Items() []T {
+	return p.items
+}
+
+// Next returns the cursor of the following page.
+func (p *page[T]) Next() string {
+	return p.next
+}
+
+// Previous returns the cursor of the preceding page.
+func (p *page[T]) Previous() string {
+	return p.previous
+}
+
+func newPage[T specs.Model](items []T, next string, previous string) specs.Page[T] {
+	return &page[T]{
+		items:    items,
+		next:     next,
+		previous: previous,
+	}
+}
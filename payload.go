@@ -13,6 +13,7 @@ func init() {
 
 type payload[T specs.Model] struct {
 	result          []T
+	each            func(T) error
 	model           T
 	modelDefinition specs.ModelDefinition
 
@@ -20,6 +21,15 @@ type payload[T specs.Model] struct {
 	joins  []specs.DriverJoin
 	wheres []specs.DriverWhere
 	limit  specs.DriverLimit
+	values []specs.DriverWhere
+	rows   [][]specs.DriverWhere
+	orders []specs.DriverOrder
+	groups []specs.DriverField
+	having []specs.DriverWhere
+	lock   specs.DriverLock
+
+	distinct  bool
+	compounds []specs.DriverCompound
 }
 
 func (p *payload[T]) Database() string {
@@ -30,6 +40,16 @@ func (p *payload[T]) Index() int {
 	return p.ModelDefinition().Index()
 }
 
+// PrimaryKey returns the field of the primary key of the model, nil when the model has none.
+func (p *payload[T]) PrimaryKey() specs.DriverField {
+	primaryField, err := p.ModelDefinition().GetPrimaryField()
+	if err != nil {
+		return nil
+	}
+
+	return primaryField.Field()
+}
+
 func (p *payload[T]) Fields() []specs.DriverField {
 	return p.fields
 }
@@ -46,6 +66,42 @@ func (p *payload[T]) Limit() specs.DriverLimit {
 	return p.limit
 }
 
+func (p *payload[T]) Values() []specs.DriverWhere {
+	return p.values
+}
+
+// Rows returns the values of each row of a multi-row insert.
+func (p *payload[T]) Rows() [][]specs.DriverWhere {
+	return p.rows
+}
+
+func (p *payload[T]) OrderBy() []specs.DriverOrder {
+	return p.orders
+}
+
+func (p *payload[T]) GroupBy() []specs.DriverField {
+	return p.groups
+}
+
+func (p *payload[T]) Having() []specs.DriverWhere {
+	return p.having
+}
+
+// Lock returns the locking clause of the select, nil when the rows are not locked.
+func (p *payload[T]) Lock() specs.DriverLock {
+	return p.lock
+}
+
+// Distinct reports whether the duplicate rows are removed from the result.
+func (p *payload[T]) Distinct() bool {
+	return p.distinct
+}
+
+// Compounds returns the queries combined with the query of the payload, its ordering and its limit apply to the combined result.
+func (p *payload[T]) Compounds() []specs.DriverCompound {
+	return p.compounds
+}
+
 func (p *payload[T]) Mapping() (mapping []any, err error) {
 	for _, field := range p.Fields() {
 		fieldDefinition, err := p.ModelDefinition().GetFieldByName(field.Name())
@@ -65,10 +121,22 @@ func (p *payload[T]) OnScan(result []any) (err error) {
 		}
 		fieldDefinition.Set(result[i])
 	}
+
+	if p.each != nil {
+		return p.each(p.ModelDefinition().Copy().(T))
+	}
+
 	p.result = append(p.result, p.ModelDefinition().Copy().(T))
 	return
 }
 
+// SetEach hands every scanned row to the callback instead of accumulating it into the result,
+// an error of the callback stops the scan.
+func (p *payload[T]) SetEach(each func(T) error) specs.PayloadAugmented[T] {
+	p.each = each
+	return p
+}
+
 func (p *payload[T]) Table() string {
 	return p.ModelDefinition().TableName()
 }
@@ -101,6 +169,54 @@ func (p *payload[T]) SetLimit(limit specs.DriverLimit) specs.Payload {
 	return p
 }
 
+func (p *payload[T]) SetValues(values []specs.DriverWhere) specs.Payload {
+	p.values = values
+
+	return p
+}
+
+func (p *payload[T]) SetRows(rows [][]specs.DriverWhere) specs.Payload {
+	p.rows = rows
+
+	return p
+}
+
+func (p *payload[T]) SetOrderBy(orders []specs.DriverOrder) specs.Payload {
+	p.orders = orders
+
+	return p
+}
+
+func (p *payload[T]) SetGroupBy(groups []specs.DriverField) specs.Payload {
+	p.groups = groups
+
+	return p
+}
+
+func (p *payload[T]) SetHaving(having []specs.DriverWhere) specs.Payload {
+	p.having = having
+
+	return p
+}
+
+func (p *payload[T]) SetLock(lock specs.DriverLock) specs.Payload {
+	p.lock = lock
+
+	return p
+}
+
+func (p *payload[T]) SetDistinct(distinct bool) specs.Payload {
+	p.distinct = distinct
+
+	return p
+}
+
+func (p *payload[T]) SetCompounds(compounds []specs.DriverCompound) specs.Payload {
+	p.compounds = compounds
+
+	return p
+}
+
 func (p *payload[T]) ModelDefinition() specs.ModelDefinition {
 	if p.modelDefinition == nil {
 		p.modelDefinition = depkit.Get[specs.UseModelDefinition]()(p.model).Parse()
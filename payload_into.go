@@ -0,0 +1,91 @@
+package dbkit
+
+import (
+	"github.com/kitstack/dbkit/definitions"
+	"github.com/kitstack/dbkit/specs"
+	"reflect"
+)
+
+type payloadInto[R any] struct {
+	specs.Payload
+
+	result []R
+
+	paths   []string
+	indexes []int
+}
+
+// Paths returns the source of each column, a field path of the model or the alias of an aggregate.
+func (p *payloadInto[R]) Paths() []string {
+	return p.paths
+}
+
+func (p *payloadInto[R]) SetPayload(payload specs.Payload) specs.PayloadInto {
+	p.Payload = payload
+	return p
+}
+
+func (p *payloadInto[R]) Mapping() (mapping []any, err error) {
+	resultType := reflect.TypeOf(p.result).Elem()
+	for _, index := range p.indexes {
+		mapping = append(mapping, reflect.New(resultType.Field(index).Type).Interface())
+	}
+	return
+}
+
+func (p *payloadInto[R]) OnScan(result []any) (err error) {
+	var current R
+
+	value := reflect.ValueOf(&current).Elem()
+	for i, index := range p.indexes {
+		value.Field(index).Set(reflect.ValueOf(result[i]).Elem())
+	}
+
+	p.result = append(p.result, current)
+	return
+}
+
+func (p *payloadInto[R]) Result() []R {
+	return p.result
+}
+
+// NewPayloadInto returns a payload scanning the rows into the struct R instead of the model.
+// Each exported field of R is read from the path named by its `dbKit:"from:..."` tag, or from its own name.
+func NewPayloadInto[R any]() specs.PayloadIntoAugmented[R] {
+	p := new(payloadInto[R])
+
+	resultType := reflect.TypeOf(p.result).Elem()
+	if resultType.Kind() != reflect.Struct {
+		return p
+	}
+
+	for i := 0; i < resultType.NumField(); i++ {
+		field := resultType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		path := field.Name
+		if from, ok := definitions.ParseTag(field.Tag.Get("dbKit"))["from"]; ok {
+			path = from
+		}
+
+		p.paths = append(p.paths, path)
+		p.indexes = append(p.indexes, i)
+	}
+
+	return p
+}
+
+// FindAllInto runs the builder and scans each row into the struct D instead of the model, the selected fields
+// and the joins are derived from the paths of D, the fields selected on the builder are ignored.
+func FindAllInto[D any, T specs.Model](builder specs.Builder[T]) ([]D, error) {
+	payload := NewPayloadInto[D]()
+
+	err := builder.FindAllInto(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.Result(), nil
+}
@@ -0,0 +1,64 @@
+package dbkit
+
+import (
+	"github.com/kitstack/dbkit/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type commentsPerUser struct {
+	UserId uint `dbKit:"from:User.Id"`
+	Total  int64
+	Last   time.Time `dbKit:"from:LastCreated"`
+	Email  string    `dbKit:"from:User.Email"`
+}
+
+type PayloadIntoTestSuite struct {
+	suite.Suite
+}
+
+func (test *PayloadIntoTestSuite) TestPaths() {
+	payload := NewPayloadInto[commentsPerUser]()
+
+	test.Equal([]string{"User.Id", "Total", "LastCreated", "User.Email"}, payload.Paths())
+}
+
+func (test *PayloadIntoTestSuite) TestPathsWithoutStruct() {
+	payload := NewPayloadInto[int]()
+
+	test.Empty(payload.Paths())
+}
+
+func (test *PayloadIntoTestSuite) TestMapping() {
+	payload := NewPayloadInto[commentsPerUser]()
+
+	mapping, err := payload.Mapping()
+	test.NoError(err)
+	test.Equal([]any{new(uint), new(int64), new(time.Time), new(string)}, mapping)
+}
+
+func (test *PayloadIntoTestSuite) TestOnScan() {
+	payload := NewPayloadInto[commentsPerUser]()
+
+	now := time.Now()
+	userId, total, email := uint(2), int64(3), "test@test.com"
+
+	err := payload.OnScan([]any{&userId, &total, &now, &email})
+	test.NoError(err)
+
+	test.Equal([]commentsPerUser{{UserId: 2, Total: 3, Last: now, Email: "test@test.com"}}, payload.Result())
+}
+
+func (test *PayloadIntoTestSuite) TestSetPayload() {
+	fakePayload := mocks.NewFakePayload(test.T())
+	fakePayload.On("Table").Return("comments").Once()
+
+	payload := NewPayloadInto[commentsPerUser]().SetPayload(fakePayload)
+
+	test.Equal("comments", payload.Table())
+}
+
+func TestPayloadIntoTestSuite(t *testing.T) {
+	suite.Run(t, new(PayloadIntoTestSuite))
+}
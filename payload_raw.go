@@ -0,0 +1,94 @@
+package dbkit
+
+import (
+	"github.com/kitstack/dbkit/specs"
+	"strings"
+)
+
+type payloadRaw[T specs.Model] struct {
+	*payload[T]
+
+	query string
+	args  []any
+}
+
+func (p *payloadRaw[T]) Query() string {
+	return p.query
+}
+
+func (p *payloadRaw[T]) Args() []any {
+	return p.args
+}
+
+// SetColumns resolves the field of each column of the result, a column is matched against the columns of the model
+// unless its name is a dotted path (e.g. `User.Email`), which is matched against the fields of the embedded relations.
+// A foreign key column (e.g. `user_id`) fills the referenced field of its relation (e.g. `User.Id`).
+func (p *payloadRaw[T]) SetColumns(columns []string) error {
+	var fields []specs.DriverField
+	for _, column := range columns {
+		field, err := p.getFieldByColumn(column)
+		if err != nil {
+			return err
+		}
+
+		fields = append(fields, field.Field())
+	}
+
+	p.SetFields(fields)
+
+	return nil
+}
+
+// getFieldByColumn returns the field scanned from the column, the fields from a slice relation are refused
+// as a row only holds one of their items.
+func (p *payloadRaw[T]) getFieldByColumn(column string) (specs.FieldDefinition, error) {
+	if !strings.Contains(column, ".") {
+		field, err := p.ModelDefinition().GetFieldByColumn(column)
+		if err == nil {
+			return field, nil
+		}
+
+		relation := p.getRelationByColumn(column)
+		if relation == nil {
+			return nil, err
+		}
+
+		return relation.GetToColumn()
+	}
+
+	field, err := p.ModelDefinition().GetFieldByName(column)
+	if err != nil {
+		return nil, err
+	}
+
+	if field.FromSlice() {
+		return nil, NewFieldNotSelectableError(QueryTypeRaw, column)
+	}
+
+	return field, nil
+}
+
+// getRelationByColumn returns the non slice relation declared on the model with the column as foreign key (e.g. `User` for `user_id`).
+func (p *payloadRaw[T]) getRelationByColumn(column string) specs.FieldDefinition {
+	for _, field := range p.ModelDefinition().Fields() {
+		relation := field.Model().FromField()
+		if relation == nil || relation.Model() != p.ModelDefinition() || relation.IsSlice() {
+			continue
+		}
+
+		if relation.Column() == column {
+			return relation
+		}
+	}
+
+	return nil
+}
+
+// NewPayloadRaw returns a payload running the query as it is written and scanning the rows into the model.
+func NewPayloadRaw[T specs.Model](query string, args ...any) specs.PayloadRawAugmented[T] {
+	return &payloadRaw[T]{
+		payload: new(payload[T]),
+		query:   query,
+		args:    args,
+	}
+}
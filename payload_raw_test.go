@@ -0,0 +1,137 @@
+package dbkit
+
+import (
+	"context"
+	"errors"
+	"github.com/kitstack/dbkit/definitions"
+	"github.com/kitstack/dbkit/specs"
+	"github.com/kitstack/dbkit/tests/mocks"
+	"github.com/kitstack/dbkit/tests/models"
+	"github.com/kitstack/depkit"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type PayloadRawTestSuite struct {
+	suite.Suite
+	context.Context
+}
+
+func (test *PayloadRawTestSuite) SetupTest() {
+	test.Context = context.Background()
+
+	depkit.Reset()
+	depkit.Register[specs.UseModelDefinition](definitions.Use)
+}
+
+func (test *PayloadRawTestSuite) TestQuery() {
+	payload := NewPayloadRaw[*models.UsersModel]("SELECT `id` FROM `users` WHERE `id` = ?", 1)
+
+	test.Equal("SELECT `id` FROM `users` WHERE `id` = ?", payload.Query())
+	test.Equal([]any{1}, payload.Args())
+}
+
+func (test *PayloadRawTestSuite) TestSetColumns() {
+	payload := NewPayloadRaw[*models.CommentsModel]("")
+
+	err := payload.SetColumns([]string{"id", "content", "User.Email", "Parent.Content"})
+	if !test.NoError(err) {
+		return
+	}
+
+	var names []string
+	for _, field := range payload.Fields() {
+		names = append(names, field.Name())
+	}
+	test.Equal([]string{"Id", "Content", "User.Email", "Parent.Content"}, names)
+}
+
+func (test *PayloadRawTestSuite) TestSetColumnsForeignKey() {
+	payload := NewPayloadRaw[*models.CommentsModel]("")
+
+	err := payload.SetColumns([]string{"id", "user_id", "post_id", "parent_id"})
+	if !test.NoError(err) {
+		return
+	}
+
+	var names []string
+	for _, field := range payload.Fields() {
+		names = append(names, field.Name())
+	}
+	test.Equal([]string{"Id", "User.Id", "PostId", "Parent.Id"}, names)
+}
+
+func (test *PayloadRawTestSuite) TestSetColumnsUnknownErr() {
+	err := NewPayloadRaw[*models.CommentsModel]("").SetColumns([]string{"id", "unknown"})
+	test.Error(err)
+
+	err = NewPayloadRaw[*models.CommentsModel]("").SetColumns([]string{"User.Unknown"})
+	test.Error(err)
+}
+
+func (test *PayloadRawTestSuite) TestSetColumnsFromSliceErr() {
+	err := NewPayloadRaw[*models.PostsModel]("").SetColumns([]string{"Comments.Content"})
+	test.ErrorAs(err, new(*FieldNotSelectableError))
+}
+
+func (test *PayloadRawTestSuite) TestRaw() {
+	fakeConnector := mocks.NewFakeConnector(test.T())
+	fakeConnector.On("Raw", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.PayloadRaw)
+		test.NoError(payload.SetColumns([]string{"id", "User.Email"}))
+
+		mapping, err := payload.Mapping()
+		test.NoError(err)
+		test.Len(mapping, 2)
+
+		id, email := uint(2), "test@test.com"
+		test.NoError(payload.OnScan([]any{&id, &email}))
+	}).Return(nil).Once()
+
+	comments, err := Raw[*models.CommentsModel](test.Context, fakeConnector, "SELECT `comments`.`id`, `users`.`email` AS `User.Email` FROM `comments` JOIN `users` ON `users`.`id` = `comments`.`user_id` WHERE `comments`.`id` = ?", 2)
+	if !test.NoError(err) || !test.Len(comments, 1) {
+		return
+	}
+
+	test.Equal(uint(2), comments[0].Id)
+	test.Equal("test@test.com", comments[0].User.Email)
+}
+
+func (test *PayloadRawTestSuite) TestRawForeignKey() {
+	fakeConnector := mocks.NewFakeConnector(test.T())
+	fakeConnector.On("Raw", test.Context, mock.Anything).Run(func(args mock.Arguments) {
+		payload := args.Get(1).(specs.PayloadRaw)
+		test.NoError(payload.SetColumns([]string{"id", "user_id", "parent_id"}))
+
+		mapping, err := payload.Mapping()
+		test.NoError(err)
+		test.Len(mapping, 3)
+
+		id, userId, parentId := uint(7), uint(2), uint(6)
+		test.NoError(payload.OnScan([]any{&id, &userId, &parentId}))
+	}).Return(nil).Once()
+
+	comments, err := Raw[*models.CommentsModel](test.Context, fakeConnector, "SELECT `id`, `user_id`, `parent_id` FROM `comments` WHERE `id` = ?", 7)
+	if !test.NoError(err) || !test.Len(comments, 1) {
+		return
+	}
+
+	test.Equal(uint(7), comments[0].Id)
+	test.Equal(uint(2), comments[0].User.Id)
+	if test.NotNil(comments[0].Parent) {
+		test.Equal(uint(6), comments[0].Parent.Id)
+	}
+}
+
+func (test *PayloadRawTestSuite) TestRawErr() {
+	fakeConnector := mocks.NewFakeConnector(test.T())
+	fakeConnector.On("Raw", test.Context, mock.Anything).Return(errors.New("raw_err")).Once()
+
+	_, err := Raw[*models.CommentsModel](test.Context, fakeConnector, "SELECT `id` FROM `comments`")
+	test.EqualError(err, "raw_err")
+}
+
+func TestPayloadRawTestSuite(t *testing.T) {
+	suite.Run(t, new(PayloadRawTestSuite))
+}
@@ -3,6 +3,8 @@ package dbkit
 import (
 	"context"
 	"errors"
+	"github.com/kitstack/dbkit/connector/drivers"
+	"github.com/kitstack/dbkit/definitions"
 	"github.com/kitstack/dbkit/specs"
 	"github.com/kitstack/dbkit/tests/mocks"
 	"github.com/kitstack/dbkit/tests/models"
@@ -113,6 +115,47 @@ func (test *PayloadTestSuite) TestOnScan() {
 	test.Equal([]*models.CommentsModel{comment}, newPayload.Result())
 }
 
+func (test *PayloadTestSuite) TestOnScanEach() {
+	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+
+	var each []*models.CommentsModel
+	newPayload := NewPayload[*models.CommentsModel]().SetEach(func(comment *models.CommentsModel) error {
+		each = append(each, comment)
+		return errors.New("each_err")
+	})
+
+	newPayload.SetFields([]specs.DriverField{
+		test.fakeDriverField,
+	})
+
+	test.fakeDriverField.On("Name").Return("Test").Once()
+	test.fakeModelDefinition.On("GetFieldByName", "Test").Return(test.fakeFieldDefinition, nil).Once()
+	test.fakeFieldDefinition.On("Set", "test").Return(nil).Once()
+
+	comment := &models.CommentsModel{Content: "test"}
+	test.fakeModelDefinition.On("Copy").Return(comment).Once()
+
+	err := newPayload.OnScan([]any{"test"})
+	test.EqualError(err, "each_err")
+	test.Equal([]*models.CommentsModel{comment}, each)
+	test.Empty(newPayload.Result())
+}
+
+func (test *PayloadTestSuite) TestPrimaryKey() {
+	test.fakeUseModelDefinition.On("Use", (*models.CommentsModel)(nil)).Return(test.fakeModelDefinition)
+	test.fakeModelDefinition.On("Parse").Return(test.fakeModelDefinition)
+
+	newPayload := NewPayload[*models.CommentsModel]()
+
+	test.fakeModelDefinition.On("GetPrimaryField").Return(test.fakeFieldDefinition, nil).Once()
+	test.fakeFieldDefinition.On("Field").Return(test.fakeDriverField).Once()
+	test.Equal(test.fakeDriverField, newPayload.PrimaryKey())
+
+	test.fakeModelDefinition.On("GetPrimaryField").Return(nil, definitions.NewErrNoPrimaryField(nil)).Once()
+	test.Nil(newPayload.PrimaryKey())
+}
+
 func (test *PayloadTestSuite) TestJoin() {
 	newPayload := NewPayload[specs.Model]()
 
@@ -128,6 +171,30 @@ func (test *PayloadTestSuite) TestLimit() {
 	test.Equal(newPayload.Limit(), test.fakeDriverLimit)
 }
 
+func (test *PayloadTestSuite) TestLock() {
+	newPayload := NewPayload[specs.Model]()
+	lock := drivers.NewLock()
+	newPayload.SetLock(lock)
+
+	test.Equal(newPayload.Lock(), lock)
+}
+
+func (test *PayloadTestSuite) TestDistinct() {
+	newPayload := NewPayload[specs.Model]()
+	test.False(newPayload.Distinct())
+
+	newPayload.SetDistinct(true)
+	test.True(newPayload.Distinct())
+}
+
+func (test *PayloadTestSuite) TestCompounds() {
+	newPayload := NewPayload[specs.Model]()
+	compounds := []specs.DriverCompound{drivers.NewCompound()}
+	newPayload.SetCompounds(compounds)
+
+	test.Equal(compounds, newPayload.Compounds())
+}
+
 func (test *PayloadTestSuite) TestWhere() {
 	newPayload := NewPayload[specs.Model]()
 	wheres := []specs.DriverWhere{test.fakeDriverWhere}
@@ -136,6 +203,46 @@ func (test *PayloadTestSuite) TestWhere() {
 	test.Equal(newPayload.Where(), wheres)
 }
 
+func (test *PayloadTestSuite) TestValues() {
+	newPayload := NewPayload[specs.Model]()
+	values := []specs.DriverWhere{test.fakeDriverWhere}
+	newPayload.SetValues(values)
+
+	test.Equal(newPayload.Values(), values)
+}
+
+func (test *PayloadTestSuite) TestRows() {
+	newPayload := NewPayload[specs.Model]()
+	rows := [][]specs.DriverWhere{{test.fakeDriverWhere}, {test.fakeDriverWhere}}
+	newPayload.SetRows(rows)
+
+	test.Equal(newPayload.Rows(), rows)
+}
+
+func (test *PayloadTestSuite) TestOrderBy() {
+	newPayload := NewPayload[specs.Model]()
+	orders := []specs.DriverOrder{drivers.NewOrder()}
+	newPayload.SetOrderBy(orders)
+
+	test.Equal(newPayload.OrderBy(), orders)
+}
+
+func (test *PayloadTestSuite) TestGroupBy() {
+	newPayload := NewPayload[specs.Model]()
+	groups := []specs.DriverField{test.fakeDriverField}
+	newPayload.SetGroupBy(groups)
+
+	test.Equal(newPayload.GroupBy(), groups)
+}
+
+func (test *PayloadTestSuite) TestHaving() {
+	newPayload := NewPayload[specs.Model]()
+	having := []specs.DriverWhere{test.fakeDriverWhere}
+	newPayload.SetHaving(having)
+
+	test.Equal(newPayload.Having(), having)
+}
+
 func (test *PayloadTestSuite) TestNew() {
 	comment := models.CommentsModel{}
 
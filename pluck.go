@@ -0,0 +1,42 @@
+package dbkit
+
+import "github.com/kitstack/dbkit/specs"
+
+// pluck is the payload of Pluck, it scans the single column of each row into V.
+type pluck[V any] struct {
+	specs.Payload
+
+	path   string
+	result []V
+}
+
+func (p *pluck[V]) Paths() []string {
+	return []string{p.path}
+}
+
+func (p *pluck[V]) SetPayload(payload specs.Payload) specs.PayloadInto {
+	p.Payload = payload
+	return p
+}
+
+func (p *pluck[V]) Mapping() ([]any, error) {
+	return []any{new(V)}, nil
+}
+
+func (p *pluck[V]) OnScan(result []any) error {
+	p.result = append(p.result, *result[0].(*V))
+	return nil
+}
+
+// Pluck runs the builder and returns the value of the field path, or of the aggregate alias, of each row
+// without hydrating the models, the fields selected on the builder are ignored.
+func Pluck[V any, T specs.Model](builder specs.Builder[T], path string) ([]V, error) {
+	payload := &pluck[V]{path: path}
+
+	err := builder.FindAllInto(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.result, nil
+}
@@ -0,0 +1,20 @@
+package dbkit
+
+import (
+	"context"
+	"github.com/kitstack/dbkit/specs"
+)
+
+// Raw runs a query written by hand and scans its rows into the model, each column of the result is matched
+// against the column of a field of the model, or against the path of a field of an embedded relation when it
+// is aliased as such (e.g. "SELECT `users`.`email` AS `User.Email`"). An unknown column is refused.
+func Raw[T specs.Model](ctx context.Context, connector specs.Connector, query string, args ...any) ([]T, error) {
+	payload := NewPayloadRaw[T](query, args...)
+
+	err := connector.Raw(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.Result(), nil
+}
@@ -0,0 +1,10 @@
+package specs
+
+// Aggregate is an aggregate function applied to a field path, selected under its alias.
+type Aggregate interface {
+	Function() string
+	From() string
+	Alias() string
+
+	As(alias string) Aggregate
+}
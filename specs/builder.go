@@ -1,29 +1,62 @@
 package specs
 
-import "context"
+import (
+	"context"
+	"iter"
+)
 
 type BuilderUse[T Model] func(ctx context.Context, connector Connector) Builder[T]
 
+// Subquery is a builder used as the value of a condition of another builder, its payload is rendered inline.
+// The index is the first table alias available, so that its aliases do not shadow the ones of the outer query.
+type Subquery interface {
+	Subquery(index int) (Payload, error)
+}
+
 type Builder[T Model] interface {
+	Subquery
+
 	Context() context.Context
 	Connector() Connector
 
 	Get(primaryKey any) (T, error)
 	Delete(primaryKey any) error
+	DeleteWhere() (int64, error)
 
 	Create() (err error)
+	Upsert() (inserted bool, err error)
+	CreateMany(models []T, batchSize int) error
 	Update() error
+	UpdateWhere(values map[string]any) (int64, error)
 
 	Find() (T, error)
 	FindAll() ([]T, error)
+	FindAllInto(payload PayloadInto) error
+	FindPage(cursor string, size int) (Page[T], error)
+	FindEach(fn func(T) error) error
+	FindSeq() iter.Seq2[T, error]
 
 	SetFields(field ...string) Builder[T]
 	SetWhere(condition Condition) Builder[T]
+	AllowUnconditional() Builder[T]
+	WithTrashed() Builder[T]
+	OnlyTrashed() Builder[T]
+	LockForUpdate(option ...LockOption) Builder[T]
+	LockForShare(option ...LockOption) Builder[T]
+	SetDistinct() Builder[T]
+	Union(other Builder[T]) Builder[T]
+	UnionAll(other Builder[T]) Builder[T]
+	Intersect(other Builder[T]) Builder[T]
+	Except(other Builder[T]) Builder[T]
 	SetLimit(limit int) Builder[T]
 	SetOffset(offset int) Builder[T]
 	SetOrderBy(fields ...string) Builder[T]
+	SetGroupBy(fields ...string) Builder[T]
+	SetHaving(condition Condition) Builder[T]
+	SetAggregates(aggregates ...Aggregate) Builder[T]
 
 	Count() (total int64, err error)
+	Exists() (bool, error)
 
 	Payload() PayloadAugmented[T]
 
@@ -1,11 +1,17 @@
 package specs
 
+// FieldPath is the path of a model field used as the value of a condition, to compare two fields together.
+type FieldPath string
+
 type Condition interface {
 	From() string
 	Operator() string
 	To() any
+	Conditions() []Condition
+	IsGroup() bool
 
 	SetFrom(from string) Condition
 	SetOperator(operator string) Condition
 	SetTo(to any) Condition
+	SetConditions(conditions ...Condition) Condition
 }
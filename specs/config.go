@@ -24,4 +24,8 @@ type Config interface {
 
 	Locale() string
 	SetLocale(locale string) Config
+
+	// MaxAllowedPacket is the max_allowed_packet of the server, the multi-row statements are kept under it.
+	MaxAllowedPacket() int
+	SetMaxAllowedPacket(size int) Config
 }
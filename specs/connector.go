@@ -1,10 +1,17 @@
 package specs
 
+import "context"
+
 type Connector interface {
 	Driver
 
 	Config() Config
 
+	// Transaction runs fn in a transaction, committed when fn returns nil and rolled back otherwise.
+	// The context given to fn carries the transaction, the queries run with it are part of the transaction.
+	// A nested call runs fn in a savepoint, only its statements are rolled back when it fails.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
 	Name() string
 	SetName(name string) Connector
 }
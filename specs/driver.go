@@ -5,9 +5,23 @@ import (
 	"database/sql"
 )
 
+// Executor runs the statements of a driver, *sql.DB and *sql.Tx both implement it.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 type Driver interface {
 	New(config Config) error
 	Get() *sql.DB
 
 	Select(ctx context.Context, payload Payload) error
+	Insert(ctx context.Context, payload Payload) (sql.Result, error)
+	Upsert(ctx context.Context, payload Payload) (sql.Result, error)
+	InsertMany(ctx context.Context, payload Payload) ([]sql.Result, error)
+	Update(ctx context.Context, payload Payload) (sql.Result, error)
+	Delete(ctx context.Context, payload Payload) (sql.Result, error)
+	Count(ctx context.Context, payload Payload) (int64, error)
+	Raw(ctx context.Context, payload PayloadRaw) error
 }
@@ -0,0 +1,12 @@
+package specs
+
+type CompoundOperator int
+
+// DriverCompound is a query combined with the query of a payload by a set operator (e.g. UNION ALL).
+type DriverCompound interface {
+	Operator() string
+	Payload() Payload
+
+	SetOperator(operator CompoundOperator) DriverCompound
+	SetPayload(payload Payload) DriverCompound
+}
@@ -8,10 +8,12 @@ type DriverJoin interface {
 	Method() string
 	From() DriverField
 	To() DriverField
+	Wheres() []DriverWhere
 
 	SetMethod(method JoinMethod) DriverJoin
 	SetFrom(field DriverField) DriverJoin
 	SetTo(field DriverField) DriverJoin
+	SetWheres(wheres ...DriverWhere) DriverJoin
 
 	Formatted() (string, error)
 }
@@ -0,0 +1,15 @@
+package specs
+
+type LockStrength int
+
+type LockOption int
+
+type DriverLock interface {
+	Strength() string
+	Option() string
+
+	SetStrength(strength LockStrength) DriverLock
+	SetOption(option LockOption) DriverLock
+
+	Formatted() (string, error)
+}
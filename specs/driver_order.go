@@ -0,0 +1,13 @@
+package specs
+
+type OrderDirection int
+
+type DriverOrder interface {
+	Direction() string
+	Field() DriverField
+
+	SetDirection(direction OrderDirection) DriverOrder
+	SetField(field DriverField) DriverOrder
+
+	Formatted() (string, error)
+}
@@ -4,10 +4,13 @@ type DriverWhere interface {
 	From() DriverField
 	Operator() string
 	To() any
+	Wheres() []DriverWhere
+	IsGroup() bool
 
 	SetFrom(from DriverField) DriverWhere
 	SetOperator(operator string) DriverWhere
 	SetTo(to any) DriverWhere
+	SetWheres(wheres ...DriverWhere) DriverWhere
 
 	Formatted() (value string, args []any, err error)
 }
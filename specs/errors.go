@@ -24,3 +24,23 @@ type ErrFieldNoFoundByColumn interface {
 	Column() string
 	ModelDefinition() ModelDefinition
 }
+
+type ErrEmptyGroup interface {
+	error
+	Operator() string
+}
+
+type ErrCompoundOrder interface {
+	error
+	Field() string
+}
+
+type ErrAutoIncrement interface {
+	error
+	Increment() int64
+}
+
+type ErrSubqueryDriver interface {
+	error
+	Operator() string
+}
@@ -39,4 +39,12 @@ type FieldDefinition interface {
 	FromSlice() bool
 
 	IsPrimaryKey() bool
+	// IsSoftDelete reports whether the field holds the deletion timestamp of its model
+	IsSoftDelete() bool
+	// IsVersion reports whether the field holds the version of its model, used for optimistic locking
+	IsVersion() bool
+	// IsAutoCreateTime reports whether the field is filled with the current time on creation
+	IsAutoCreateTime() bool
+	// IsAutoUpdateTime reports whether the field is filled with the current time on creation and on every update
+	IsAutoUpdateTime() bool
 }
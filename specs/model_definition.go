@@ -13,6 +13,8 @@ type ModelDefinition interface {
 	GetFieldByName(name string) (FieldDefinition, ErrNotFoundError)
 	GetPrimaryField() (FieldDefinition, ErrPrimaryFieldNotFound)
 	GetFieldByColumn(column string) (FieldDefinition, ErrFieldNoFoundByColumn)
+	GetSoftDeleteField() FieldDefinition
+	GetVersionField() FieldDefinition
 
 	SetFromField(fromField FieldDefinition) ModelDefinition
 	FromField() FieldDefinition
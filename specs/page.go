@@ -0,0 +1,8 @@
+package specs
+
+// Page is a page of a keyset pagination, the cursors are opaque and empty when there is no page in that direction.
+type Page[T Model] interface {
+	Items() []T
+	Next() string
+	Previous() string
+}
@@ -6,16 +6,33 @@ type Payload interface {
 	Table() string
 	Database() string
 	Index() int
+	PrimaryKey() DriverField
 
 	Fields() []DriverField
 	Join() []DriverJoin
 	Where() []DriverWhere
 	Limit() DriverLimit
+	Values() []DriverWhere
+	Rows() [][]DriverWhere
+	OrderBy() []DriverOrder
+	GroupBy() []DriverField
+	Having() []DriverWhere
+	Lock() DriverLock
+	Distinct() bool
+	Compounds() []DriverCompound
 
 	SetFields([]DriverField) Payload
 	SetJoins([]DriverJoin) Payload
 	SetWheres([]DriverWhere) Payload
 	SetLimit(DriverLimit) Payload
+	SetValues([]DriverWhere) Payload
+	SetRows([][]DriverWhere) Payload
+	SetOrderBy([]DriverOrder) Payload
+	SetGroupBy([]DriverField) Payload
+	SetHaving([]DriverWhere) Payload
+	SetLock(DriverLock) Payload
+	SetDistinct(bool) Payload
+	SetCompounds([]DriverCompound) Payload
 
 	Mapping() ([]any, error)
 	OnScan([]any) error
@@ -24,4 +41,30 @@ type Payload interface {
 type PayloadAugmented[T Model] interface {
 	Payload
 	Result() []T
+	SetEach(each func(T) error) PayloadAugmented[T]
+}
+
+// PayloadInto is a payload scanning the rows into a struct other than the model, the query parts come from the payload of the model.
+type PayloadInto interface {
+	Payload
+	Paths() []string
+	SetPayload(payload Payload) PayloadInto
+}
+
+type PayloadIntoAugmented[R any] interface {
+	PayloadInto
+	Result() []R
+}
+
+// PayloadRaw is a payload running a query written by hand, its fields are resolved from the columns of the result.
+type PayloadRaw interface {
+	Payload
+	Query() string
+	Args() []any
+	SetColumns(columns []string) error
+}
+
+type PayloadRawAugmented[T Model] interface {
+	PayloadRaw
+	Result() []T
 }
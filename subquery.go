@@ -0,0 +1,22 @@
+package dbkit
+
+import "github.com/kitstack/dbkit/specs"
+
+// subquery is the payload of a builder rendered inside the query of another builder,
+// its table alias is the index it has been built from instead of the one of the model.
+type subquery struct {
+	specs.Payload
+
+	index int
+}
+
+func (s *subquery) Index() int {
+	return s.index
+}
+
+func newSubquery(payload specs.Payload, index int) specs.Payload {
+	return &subquery{
+		Payload: payload,
+		index:   index,
+	}
+}
@@ -50,6 +50,37 @@ INSERT INTO `comments` VALUES (1,2,1,NULL,'Je pratique la méditation tous les j
 /*!40000 ALTER TABLE `comments` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `notes`
+--
+
+DROP TABLE IF EXISTS `notes`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `notes` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `parent_id` int DEFAULT NULL,
+  `content` text NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  KEY `parent_id` (`parent_id`),
+  CONSTRAINT `notes_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `notes_ibfk_2` FOREIGN KEY (`parent_id`) REFERENCES `notes` (`id`)
+) ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `notes`
+--
+
+LOCK TABLES `notes` WRITE;
+/*!40000 ALTER TABLE `notes` DISABLE KEYS */;
+INSERT INTO `notes` VALUES (1,1,NULL,'Penser à relire l\'article sur la méditation.',NULL),(2,2,1,'Je l\'ai relu, il est très bien.',NULL),(3,3,NULL,'Note supprimée.','2023-04-12 16:00:00'),(4,1,3,'Réponse à la note supprimée.',NULL);
+/*!40000 ALTER TABLE `notes` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `posts`
 --
@@ -65,6 +96,7 @@ CREATE TABLE `posts` (
   `content` text NOT NULL,
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
   `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `version` int unsigned NOT NULL DEFAULT '0',
   PRIMARY KEY (`id`),
   KEY `u_user_id` (`u_user_id`),
   CONSTRAINT `posts_ibfk_1` FOREIGN KEY (`u_user_id`) REFERENCES `users` (`id`)
@@ -77,7 +109,7 @@ CREATE TABLE `posts` (
 
 LOCK TABLES `posts` WRITE;
 /*!40000 ALTER TABLE `posts` DISABLE KEYS */;
-INSERT INTO `posts` VALUES (1,1,2,'Les bienfaits de la méditation','La méditation est une pratique ancienne qui peut améliorer notre bien-être mental et physique. Des études ont montré qu\'elle peut réduire le stress, améliorer la concentration et même réduire la douleur chronique. Si vous cherchez à améliorer votre qualité de vie, essayez la méditation !','2023-03-28 02:38:05','2023-03-28 02:38:05'),(2,2,NULL,'Mon voyage en Asie','Je suis récemment allé en Asie et j\'ai eu l\'opportunité de découvrir de nouvelles cultures et de nouveaux paysages incroyables. J\'ai visité le Japon, la Chine et la Thaïlande, et chaque endroit avait sa propre beauté unique. Je recommande fortement à tout le monde de voyager en Asie au moins une fois dans leur vie.','2023-03-28 02:38:05','2023-03-28 02:38:05'),(3,3,NULL,'Comment apprendre une nouvelle langue rapidement','Apprendre une nouvelle langue peut sembler intimidant, mais avec la bonne approche, c\'est plus facile que vous ne le pensez. Voici quelques conseils pour apprendre une langue rapidement : pratiquez régulièrement, écoutez de la musique dans la langue que vous apprenez, regardez des films et des séries en version originale, et trouvez un tuteur ou un ami natif pour pratiquer avec vous.','2023-03-28 02:38:05','2023-03-28 02:38:05'),(4,1,NULL,'Ma recette préférée : poulet rôti aux herbes','Le poulet rôti aux herbes est l\'un de mes plats préférés. C\'est facile à faire et ça a tellement de saveur ! Voici ma recette : mélangez du romarin, du thym, de l\'ail, du sel et du poivre dans un bol. Ensuite, frottez le mélange sur un poulet entier et placez-le dans un plat allant au four. Faites cuire le poulet à 180°C pendant environ une heure et demie, ou jusqu\'à ce que le jus qui s\'écoule du poulet soit clair. Servez avec des légumes grillés pour un repas délicieux et sain.','2023-03-28 02:38:05','2023-03-28 02:38:05');
+INSERT INTO `posts` VALUES (1,1,2,'Les bienfaits de la méditation','La méditation est une pratique ancienne qui peut améliorer notre bien-être mental et physique. Des études ont montré qu\'elle peut réduire le stress, améliorer la concentration et même réduire la douleur chronique. Si vous cherchez à améliorer votre qualité de vie, essayez la méditation !','2023-03-28 02:38:05','2023-03-28 02:38:05',0),(2,2,NULL,'Mon voyage en Asie','Je suis récemment allé en Asie et j\'ai eu l\'opportunité de découvrir de nouvelles cultures et de nouveaux paysages incroyables. J\'ai visité le Japon, la Chine et la Thaïlande, et chaque endroit avait sa propre beauté unique. Je recommande fortement à tout le monde de voyager en Asie au moins une fois dans leur vie.','2023-03-28 02:38:05','2023-03-28 02:38:05',0),(3,3,NULL,'Comment apprendre une nouvelle langue rapidement','Apprendre une nouvelle langue peut sembler intimidant, mais avec la bonne approche, c\'est plus facile que vous ne le pensez. Voici quelques conseils pour apprendre une langue rapidement : pratiquez régulièrement, écoutez de la musique dans la langue que vous apprenez, regardez des films et des séries en version originale, et trouvez un tuteur ou un ami natif pour pratiquer avec vous.','2023-03-28 02:38:05','2023-03-28 02:38:05',0),(4,1,NULL,'Ma recette préférée : poulet rôti aux herbes','Le poulet rôti aux herbes est l\'un de mes plats préférés. C\'est facile à faire et ça a tellement de saveur ! Voici ma recette : mélangez du romarin, du thym, de l\'ail, du sel et du poivre dans un bol. Ensuite, frottez le mélange sur un poulet entier et placez-le dans un plat allant au four. Faites cuire le poulet à 180°C pendant environ une heure et demie, ou jusqu\'à ce que le jus qui s\'écoule du poulet soit clair. Servez avec des légumes grillés pour un repas délicieux et sain.','2023-03-28 02:38:05','2023-03-28 02:38:05',0);
 /*!40000 ALTER TABLE `posts` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -0,0 +1,51 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/tests/models"
+	"time"
+)
+
+type commentsPerUser struct {
+	UserId uint   `dbKit:"from:User.Id"`
+	Email  string `dbKit:"from:User.Email"`
+	Total  int64
+	Last   time.Time `dbKit:"from:LastCreated"`
+}
+
+func (fixture *Fixture) BuilderAggregate(ctx context.Context) (err error) {
+
+	result, err := dbkit.Aggregate[commentsPerUser](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetGroupBy("User.Id", "User.Email").
+			SetOrderBy("-Total", "User.Id"),
+		dbkit.Count("Id").As("Total"),
+		dbkit.Max("Created").As("LastCreated"),
+	)
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(result, 3) {
+		fixture.Assert().EqualValues(2, result[0].UserId)
+		fixture.Assert().EqualValues(3, result[0].Total)
+		fixture.Assert().NotEmpty(result[0].Email)
+		fixture.Assert().False(result[0].Last.IsZero())
+		fixture.Assert().EqualValues(3, result[1].UserId)
+		fixture.Assert().EqualValues(1, result[2].UserId)
+		fixture.Assert().EqualValues(2, result[2].Total)
+	}
+
+	result, err = dbkit.Aggregate[commentsPerUser](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetGroupBy("User.Id", "User.Email").
+			SetHaving(dbkit.NewCondition().SetFrom("Total").SetOperator(operators.Greater).SetTo(2)),
+		dbkit.Count("Id").As("Total"),
+		dbkit.Max("Created").As("LastCreated"),
+	)
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Len(result, 2)
+
+	return
+}
@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) BuilderCount(ctx context.Context) (err error) {
+
+	total, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).Count()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(8, total)
+
+	total, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id", "Content").
+		SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(4)).
+		SetLimit(1).
+		Count()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(3, total)
+
+	total, err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("Comments.Id").SetOperator(operators.Greater).SetTo(0)).
+		Count()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(4, total)
+
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetGroupBy("PostId").Count()
+	fixture.Assert().Error(err)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) BuilderCreate(ctx context.Context) (err error) {
+	comment := &models.CommentsModel{
+		User:    models.UsersModel{Id: 1},
+		PostId:  3,
+		Content: "Created by the acceptance tests",
+	}
+
+	err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).Create()
+	fixture.Assert().NoError(err)
+	fixture.Assert().NotZero(comment.Id)
+	fixture.Assert().NotZero(comment.Created)
+	fixture.Assert().Equal(comment.Created, comment.Updated)
+
+	defer fixture.Connector().Get().ExecContext(ctx, "DELETE FROM `comments` WHERE `id` = ?", comment.Id)
+
+	created, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content", "User.Id").Get(comment.Id)
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal(comment.Content, created.Content)
+	fixture.Assert().EqualValues(1, created.User.Id)
+
+	return
+}
+
+func (fixture *Fixture) BuilderUpsert(ctx context.Context) (err error) {
+	comment := &models.CommentsModel{
+		User:    models.UsersModel{Id: 1},
+		PostId:  3,
+		Content: "Upserted by the acceptance tests",
+	}
+
+	inserted, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).Upsert()
+	fixture.Assert().NoError(err)
+	fixture.Assert().True(inserted)
+	fixture.Assert().NotZero(comment.Id)
+
+	defer fixture.Connector().Get().ExecContext(ctx, "DELETE FROM `comments` WHERE `id` = ?", comment.Id)
+
+	comment.Content = "Updated by the acceptance tests"
+	inserted, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).Upsert()
+	fixture.Assert().NoError(err)
+	fixture.Assert().False(inserted)
+
+	comment.Content = "Never written"
+	comment.User.Id = 2
+	inserted, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).SetFields("Updated").Upsert()
+	fixture.Assert().NoError(err)
+	fixture.Assert().False(inserted)
+
+	upserted, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content", "User.Id").Get(comment.Id)
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal("Updated by the acceptance tests", upserted.Content)
+	fixture.Assert().EqualValues(1, upserted.User.Id)
+
+	return
+}
+
+func (fixture *Fixture) BuilderCreateMany(ctx context.Context) (err error) {
+	var comments []*models.CommentsModel
+	for i := 0; i < 5; i++ {
+		comments = append(comments, &models.CommentsModel{
+			User:    models.UsersModel{Id: 2},
+			PostId:  3,
+			Content: fmt.Sprintf("Created in batch %d by the acceptance tests", i),
+		})
+	}
+
+	err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).CreateMany(comments, 2)
+	fixture.Assert().NoError(err)
+
+	for _, comment := range comments {
+		if !fixture.Assert().NotZero(comment.Id) {
+			continue
+		}
+
+		defer fixture.Connector().Get().ExecContext(ctx, "DELETE FROM `comments` WHERE `id` = ?", comment.Id)
+
+		created, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content").Get(comment.Id)
+		fixture.Assert().NoError(err)
+		fixture.Assert().Equal(comment.Content, created.Content)
+	}
+
+	return
+}
@@ -0,0 +1,85 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) BuilderDelete(ctx context.Context) (err error) {
+	for i := 0; i < 2; i++ {
+		comment := &models.CommentsModel{
+			User:    models.UsersModel{Id: 1},
+			PostId:  3,
+			Content: "Deleted by the acceptance tests",
+		}
+
+		err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).Create()
+		if !fixture.Assert().NoError(err) {
+			return
+		}
+
+		if i == 0 {
+			err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).Delete(comment.Id)
+			fixture.Assert().NoError(err)
+
+			_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(comment.Id)
+			fixture.Assert().ErrorContains(err, "empty result")
+		}
+	}
+
+	rowsAffected, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("Content").SetOperator(operators.Equal).SetTo("Deleted by the acceptance tests")).
+		DeleteWhere()
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(1, rowsAffected)
+
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).DeleteWhere()
+	fixture.Assert().Error(err)
+
+	return nil
+}
+
+func (fixture *Fixture) BuilderSoftDelete(ctx context.Context) (err error) {
+	total, err := dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).Count()
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(3, total)
+
+	total, err = dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).WithTrashed().Count()
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(4, total)
+
+	total, err = dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).OnlyTrashed().Count()
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(1, total)
+
+	// The reply to the removed note is excluded by the join on its parent.
+	notes, err := dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).SetFields("Id", "Parent.Id").FindAll()
+	if fixture.Assert().NoError(err) && fixture.Assert().Len(notes, 1) {
+		fixture.Assert().EqualValues(2, notes[0].Id)
+		fixture.Assert().EqualValues(1, notes[0].Parent.Id)
+	}
+
+	err = dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).Delete(1)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	_, err = dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).SetFields("Id").Get(1)
+	fixture.Assert().ErrorContains(err, "empty result")
+
+	note, err := dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).SetFields("Id", "Deleted").WithTrashed().Get(1)
+	if fixture.Assert().NoError(err) {
+		fixture.Assert().NotNil(note.Deleted)
+	}
+
+	rowsAffected, err := dbkit.Use[*models.NotesModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1)).
+		OnlyTrashed().
+		UpdateWhere(map[string]any{"Deleted": nil})
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(1, rowsAffected)
+
+	return nil
+}
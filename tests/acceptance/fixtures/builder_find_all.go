@@ -0,0 +1,343 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/specs"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) BuilderFindAllWithLimit(ctx context.Context) (err error) {
+
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").SetLimit(2).SetOffset(2).FindAll()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Len(comments, 2)
+
+	comments, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").SetOffset(6).FindAll()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Len(comments, 2)
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllWithOrderBy(ctx context.Context) (err error) {
+
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "User.Email").SetOrderBy("-Id").SetLimit(2).FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 2) {
+		fixture.Assert().EqualValues(8, comments[0].Id)
+		fixture.Assert().EqualValues(7, comments[1].Id)
+	}
+
+	comments, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "User.Email").SetOrderBy("User.Email", "-Id").FindAll()
+
+	fixture.Assert().NoError(err)
+	for i := 1; i < len(comments); i++ {
+		fixture.Assert().LessOrEqual(comments[i-1].User.Email, comments[i].User.Email)
+	}
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllWithConditionGroups(ctx context.Context) (err error) {
+
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetWhere(dbkit.Or(
+			dbkit.NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1),
+			dbkit.And(
+				dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(4),
+				dbkit.Not(dbkit.NewCondition().SetFrom("Id").SetOperator(operators.In).SetTo([]int{6})),
+			),
+		)).
+		SetOrderBy("Id").
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 3) {
+		fixture.Assert().EqualValues(1, comments[0].Id)
+		fixture.Assert().EqualValues(7, comments[1].Id)
+		fixture.Assert().EqualValues(8, comments[2].Id)
+	}
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllWithFieldComparison(ctx context.Context) (err error) {
+
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetWhere(dbkit.NewCondition().SetFrom("Post.Creator.Id").SetOperator(operators.Equal).SetTo(dbkit.Field("User.Id"))).
+		SetOrderBy("Id").
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 3) {
+		fixture.Assert().EqualValues(4, comments[0].Id)
+		fixture.Assert().EqualValues(5, comments[1].Id)
+		fixture.Assert().EqualValues(6, comments[2].Id)
+	}
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllWithSubquery(ctx context.Context) (err error) {
+
+	posts, err := dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetWhere(dbkit.Exists("Comments", dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(1)))).
+		SetOrderBy("Id").
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(posts, 2) {
+		fixture.Assert().EqualValues(2, posts[0].Id)
+		fixture.Assert().EqualValues(4, posts[1].Id)
+	}
+
+	posts, err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetWhere(dbkit.NotExists("Comments", dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(1)))).
+		SetOrderBy("Id").
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(posts, 2) {
+		fixture.Assert().EqualValues(1, posts[0].Id)
+		fixture.Assert().EqualValues(3, posts[1].Id)
+	}
+
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetWhere(dbkit.NewCondition().SetFrom("PostId").SetOperator(operators.In).SetTo(dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+			SetFields("Id").
+			SetWhere(dbkit.NewCondition().SetFrom("Creator.Id").SetOperator(operators.Equal).SetTo(1)))).
+		SetOrderBy("Id").
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 5) {
+		fixture.Assert().EqualValues([]uint{1, 2, 6, 7, 8}, []uint{comments[0].Id, comments[1].Id, comments[2].Id, comments[3].Id, comments[4].Id})
+	}
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindPage(ctx context.Context) (err error) {
+
+	ids := func(page specs.Page[*models.CommentsModel]) (ids []uint) {
+		for _, comment := range page.Items() {
+			ids = append(ids, comment.Id)
+		}
+		return
+	}
+
+	first, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("-Post.Id").
+		FindPage("", 3)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+	fixture.Assert().Equal([]uint{6, 7, 8}, ids(first))
+	fixture.Assert().Empty(first.Previous())
+
+	second, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("-Post.Id").
+		FindPage(first.Next(), 3)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+	fixture.Assert().Equal([]uint{5, 3, 4}, ids(second))
+
+	last, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("-Post.Id").
+		FindPage(second.Next(), 3)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+	fixture.Assert().Equal([]uint{1, 2}, ids(last))
+	fixture.Assert().Empty(last.Next())
+
+	previous, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("-Post.Id").
+		FindPage(last.Previous(), 3)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+	fixture.Assert().Equal([]uint{5, 3, 4}, ids(previous))
+	fixture.Assert().Equal(second.Next(), previous.Next())
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindEach(ctx context.Context) (err error) {
+
+	var ids []uint
+	err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("Id").
+		FindEach(func(comment *models.CommentsModel) error {
+			ids = append(ids, comment.Id)
+			return nil
+		})
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal([]uint{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+
+	ids = nil
+	for comment, err := range dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").SetOrderBy("Id").FindSeq() {
+		if !fixture.Assert().NoError(err) {
+			break
+		}
+
+		ids = append(ids, comment.Id)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	fixture.Assert().Equal([]uint{1, 2, 3}, ids)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ids = nil
+	err = dbkit.Use[*models.CommentsModel](cancelCtx, fixture.Connector()).
+		SetFields("Id").
+		SetOrderBy("Id").
+		FindEach(func(comment *models.CommentsModel) error {
+			ids = append(ids, comment.Id)
+			cancel()
+			return nil
+		})
+	fixture.Assert().ErrorIs(err, context.Canceled)
+	fixture.Assert().Equal([]uint{1}, ids)
+
+	return nil
+}
+
+type commentSummary struct {
+	Id          uint
+	AuthorEmail string `dbKit:"from:User.Email"`
+	PostTitle   string `dbKit:"from:Post.Title"`
+}
+
+func (fixture *Fixture) BuilderFindAllIntoStruct(ctx context.Context) (err error) {
+
+	result, err := dbkit.FindAllInto[commentSummary](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(1)).
+			SetOrderBy("Id"),
+	)
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(result, 2) {
+		fixture.Assert().EqualValues(1, result[0].Id)
+		fixture.Assert().Equal("jane.smith@example.com", result[0].AuthorEmail)
+		fixture.Assert().Equal("Les bienfaits de la méditation", result[0].PostTitle)
+		fixture.Assert().Equal("emily.davis@example.com", result[1].AuthorEmail)
+	}
+
+	return
+}
+
+func (fixture *Fixture) BuilderExists(ctx context.Context) (err error) {
+
+	exists, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("jane.smith@example.com")).
+		Exists()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().True(exists)
+
+	exists, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("User.Email").SetOperator(operators.Equal).SetTo("unknown@example.com")).
+		Exists()
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().False(exists)
+
+	return
+}
+
+func (fixture *Fixture) BuilderPluck(ctx context.Context) (err error) {
+
+	ids, err := dbkit.Pluck[uint](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(4)).
+			SetOrderBy("Id"),
+		"Id",
+	)
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal([]uint{6, 7, 8}, ids)
+
+	emails, err := dbkit.Pluck[string](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(1)).
+			SetOrderBy("Id"),
+		"User.Email",
+	)
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal([]string{"jane.smith@example.com", "emily.davis@example.com"}, emails)
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllDistinct(ctx context.Context) (err error) {
+
+	emails, err := dbkit.Pluck[string](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetDistinct().SetOrderBy("User.Email"),
+		"User.Email",
+	)
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal([]string{"emily.davis@example.com", "jane.smith@example.com", "john.doe@example.com"}, emails)
+
+	return
+}
+
+func (fixture *Fixture) BuilderFindAllCompound(ctx context.Context) (err error) {
+
+	// The comments of the posts 1 and 4, except the ones written by the user 3.
+	comments, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetFields("Id", "User.Email").
+		SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(1)).
+		UnionAll(dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(4))).
+		Except(dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("User.Id").SetOperator(operators.Equal).SetTo(3))).
+		SetOrderBy("-Id").
+		SetLimit(2).
+		FindAll()
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 2) {
+		fixture.Assert().EqualValues(7, comments[0].Id)
+		fixture.Assert().Equal("jane.smith@example.com", comments[0].User.Email)
+		fixture.Assert().EqualValues(6, comments[1].Id)
+	}
+
+	// The comments of the post 4 which have been answered.
+	ids, err := dbkit.Pluck[uint](
+		dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+			SetWhere(dbkit.NewCondition().SetFrom("Post.Id").SetOperator(operators.Equal).SetTo(4)).
+			Intersect(dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Parent.Id")).
+			SetOrderBy("Id"),
+		"Id",
+	)
+
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal([]uint{6, 7}, ids)
+
+	return
+}
@@ -0,0 +1,77 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) BuilderUpdate(ctx context.Context) (err error) {
+	original, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content").Get(1)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	defer fixture.Connector().Get().ExecContext(ctx, "UPDATE `comments` SET `content` = ? WHERE `id` = ?", original.Content, original.Id)
+
+	comment := &models.CommentsModel{Id: original.Id, Content: "Updated by the acceptance tests"}
+	err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).SetFields("Content").Update()
+	fixture.Assert().NoError(err)
+	fixture.Assert().NotZero(comment.Updated)
+
+	updated, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content").Get(original.Id)
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal(comment.Content, updated.Content)
+
+	return
+}
+
+func (fixture *Fixture) BuilderUpdateWhere(ctx context.Context) (err error) {
+	original, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content").Get(5)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	defer fixture.Connector().Get().ExecContext(ctx, "UPDATE `comments` SET `content` = ? WHERE `id` = ?", original.Content, original.Id)
+
+	rowsAffected, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).
+		SetWhere(dbkit.NewCondition().SetFrom("Post.Creator.Id").SetOperator(operators.Equal).SetTo(3)).
+		UpdateWhere(map[string]any{"Content": "Hidden by the acceptance tests"})
+	fixture.Assert().NoError(err)
+	fixture.Assert().EqualValues(1, rowsAffected)
+
+	updated, err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id", "Content").Get(original.Id)
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal("Hidden by the acceptance tests", updated.Content)
+
+	return
+}
+
+func (fixture *Fixture) BuilderUpdateVersion(ctx context.Context) (err error) {
+	original, err := dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).SetFields("Id", "Title", "Version").Get(2)
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	defer fixture.Connector().Get().ExecContext(ctx, "UPDATE `posts` SET `title` = ?, `version` = ? WHERE `id` = ?", original.Title, original.Version, original.Id)
+
+	// Two editors read the same post, the second one to write is refused.
+	first := &models.PostsModel{Id: original.Id, Title: "Edited by the first editor", Version: original.Version}
+	second := &models.PostsModel{Id: original.Id, Title: "Edited by the second editor", Version: original.Version}
+
+	err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).SetModel(first).SetFields("Title").Update()
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal(original.Version+1, first.Version)
+
+	err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).SetModel(second).SetFields("Title").Update()
+	staleErr := &dbkit.ErrStaleObject{}
+	fixture.Assert().ErrorAs(err, &staleErr)
+
+	updated, err := dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).SetFields("Id", "Title", "Version").Get(original.Id)
+	fixture.Assert().NoError(err)
+	fixture.Assert().Equal(first.Title, updated.Title)
+	fixture.Assert().Equal(first.Version, updated.Version)
+
+	return
+}
@@ -0,0 +1,128 @@
+package fixtures
+
+import (
+	"context"
+	"errors"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/connector/drivers/locks"
+	"github.com/kitstack/dbkit/connector/drivers/operators"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) ConnectorTransaction(ctx context.Context) (err error) {
+	comment := &models.CommentsModel{User: models.UsersModel{Id: 1}, PostId: 3, Content: "Committed by the acceptance tests"}
+
+	err = fixture.Connector().Transaction(ctx, func(ctx context.Context) error {
+		return dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(comment).Create()
+	})
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	defer fixture.Connector().Get().ExecContext(ctx, "DELETE FROM `comments` WHERE `id` = ?", comment.Id)
+
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(comment.Id)
+	fixture.Assert().NoError(err)
+
+	// The comment created in a rolled back transaction is visible inside the transaction only.
+	rolledBack := &models.CommentsModel{User: models.UsersModel{Id: 1}, PostId: 3, Content: "Rolled back by the acceptance tests"}
+	err = fixture.Connector().Transaction(ctx, func(ctx context.Context) error {
+		err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(rolledBack).Create()
+		if err != nil {
+			return err
+		}
+
+		_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(rolledBack.Id)
+		fixture.Assert().NoError(err)
+
+		return errors.New("rollback")
+	})
+	fixture.Assert().EqualError(err, "rollback")
+
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(rolledBack.Id)
+	fixture.Assert().ErrorContains(err, "empty result")
+
+	return nil
+}
+
+func (fixture *Fixture) ConnectorTransactionSavepoint(ctx context.Context) (err error) {
+	outer := &models.CommentsModel{User: models.UsersModel{Id: 1}, PostId: 3, Content: "Committed around a savepoint by the acceptance tests"}
+	inner := &models.CommentsModel{User: models.UsersModel{Id: 1}, PostId: 3, Content: "Rolled back to a savepoint by the acceptance tests"}
+
+	err = fixture.Connector().Transaction(ctx, func(ctx context.Context) error {
+		err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(outer).Create()
+		if err != nil {
+			return err
+		}
+
+		err = fixture.Connector().Transaction(ctx, func(ctx context.Context) error {
+			err := dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetModel(inner).Create()
+			if err != nil {
+				return err
+			}
+
+			return errors.New("rollback")
+		})
+		fixture.Assert().EqualError(err, "rollback")
+
+		return nil
+	})
+	if !fixture.Assert().NoError(err) {
+		return
+	}
+
+	defer fixture.Connector().Get().ExecContext(ctx, "DELETE FROM `comments` WHERE `id` = ?", outer.Id)
+
+	// Only the comment of the savepoint has been rolled back.
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(outer.Id)
+	fixture.Assert().NoError(err)
+
+	_, err = dbkit.Use[*models.CommentsModel](ctx, fixture.Connector()).SetFields("Id").Get(inner.Id)
+	fixture.Assert().ErrorContains(err, "empty result")
+
+	return nil
+}
+
+func (fixture *Fixture) ConnectorTransactionLock(ctx context.Context) (err error) {
+	_, err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).SetFields("Id").LockForUpdate().FindAll()
+	fixture.Assert().ErrorAs(err, new(*dbkit.TransactionRequiredError))
+
+	err = fixture.Connector().Transaction(ctx, func(lockCtx context.Context) error {
+		_, err := dbkit.Use[*models.PostsModel](lockCtx, fixture.Connector()).
+			SetFields("Id").
+			SetWhere(dbkit.NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1)).
+			LockForUpdate().
+			FindAll()
+		if err != nil {
+			return err
+		}
+
+		// A second transaction skips the row locked by the first one.
+		return fixture.Connector().Transaction(ctx, func(ctx context.Context) error {
+			posts, err := dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+				SetFields("Id").
+				SetOrderBy("Id").
+				LockForUpdate(locks.SkipLocked).
+				FindAll()
+			if err != nil {
+				return err
+			}
+
+			for _, post := range posts {
+				fixture.Assert().NotEqual(uint(1), post.Id)
+			}
+
+			_, err = dbkit.Use[*models.PostsModel](ctx, fixture.Connector()).
+				SetFields("Id").
+				SetWhere(dbkit.NewCondition().SetFrom("Id").SetOperator(operators.Equal).SetTo(1)).
+				LockForShare(locks.NoWait).
+				FindAll()
+			fixture.Assert().Error(err)
+
+			return nil
+		})
+	})
+	fixture.Assert().NoError(err)
+
+	return nil
+}
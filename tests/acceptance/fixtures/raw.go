@@ -0,0 +1,31 @@
+package fixtures
+
+import (
+	"context"
+	"github.com/kitstack/dbkit"
+	"github.com/kitstack/dbkit/tests/models"
+)
+
+func (fixture *Fixture) Raw(ctx context.Context) (err error) {
+	comments, err := dbkit.Raw[*models.CommentsModel](ctx, fixture.Connector(),
+		"SELECT `c`.`id`, `c`.`content`, `u`.`id` AS `User.Id`, `u`.`email` AS `User.Email` FROM `comments` AS `c` JOIN `users` AS `u` ON `u`.`id` = `c`.`user_id` WHERE `c`.`post_id` = ? ORDER BY `c`.`id`", 1)
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 2) {
+		fixture.Assert().EqualValues(1, comments[0].Id)
+		fixture.Assert().NotEmpty(comments[0].Content)
+		fixture.Assert().EqualValues(2, comments[0].User.Id)
+		fixture.Assert().Equal("jane.smith@example.com", comments[0].User.Email)
+		fixture.Assert().Equal("emily.davis@example.com", comments[1].User.Email)
+	}
+
+	comments, err = dbkit.Raw[*models.CommentsModel](ctx, fixture.Connector(), "SELECT `id`, `user_id` FROM `comments` WHERE `post_id` = ? ORDER BY `id`", 1)
+
+	fixture.Assert().NoError(err)
+	if fixture.Assert().Len(comments, 2) {
+		fixture.Assert().EqualValues(2, comments[0].User.Id)
+		fixture.Assert().EqualValues(3, comments[1].User.Id)
+	}
+
+	return nil
+}
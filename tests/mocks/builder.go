@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/kitstack/dbkit/specs"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +13,22 @@ type FakeBuilder[T specs.Model] struct {
 	mock.Mock
 }
 
+// AllowUnconditional provides a mock function with given fields:
+func (_m *FakeBuilder[T]) AllowUnconditional() specs.Builder[T] {
+	ret := _m.Called()
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func() specs.Builder[T]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 // Connector provides a mock function with given fields:
 func (_m *FakeBuilder[T]) Connector() specs.Connector {
 	ret := _m.Called()
@@ -82,6 +99,20 @@ func (_m *FakeBuilder[T]) Create() error {
 	return r0
 }
 
+// CreateMany provides a mock function with given fields: models, batchSize
+func (_m *FakeBuilder[T]) CreateMany(models []T, batchSize int) error {
+	ret := _m.Called(models, batchSize)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]T, int) error); ok {
+		r0 = rf(models, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: primaryKey
 func (_m *FakeBuilder[T]) Delete(primaryKey interface{}) error {
 	ret := _m.Called(primaryKey)
@@ -96,6 +127,70 @@ func (_m *FakeBuilder[T]) Delete(primaryKey interface{}) error {
 	return r0
 }
 
+// DeleteWhere provides a mock function with given fields:
+func (_m *FakeBuilder[T]) DeleteWhere() (int64, error) {
+	ret := _m.Called()
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Except provides a mock function with given fields: other
+func (_m *FakeBuilder[T]) Except(other specs.Builder[T]) specs.Builder[T] {
+	ret := _m.Called(other)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(specs.Builder[T]) specs.Builder[T]); ok {
+		r0 = rf(other)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// Exists provides a mock function with given fields:
+func (_m *FakeBuilder[T]) Exists() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Fields provides a mock function with given fields:
 func (_m *FakeBuilder[T]) Fields() []string {
 	ret := _m.Called()
@@ -162,6 +257,76 @@ func (_m *FakeBuilder[T]) FindAll() ([]T, error) {
 	return r0, r1
 }
 
+// FindAllInto provides a mock function with given fields: payload
+func (_m *FakeBuilder[T]) FindAllInto(payload specs.PayloadInto) error {
+	ret := _m.Called(payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(specs.PayloadInto) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindEach provides a mock function with given fields: fn
+func (_m *FakeBuilder[T]) FindEach(fn func(T) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(T) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindPage provides a mock function with given fields: cursor, size
+func (_m *FakeBuilder[T]) FindPage(cursor string, size int) (specs.Page[T], error) {
+	ret := _m.Called(cursor, size)
+
+	var r0 specs.Page[T]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (specs.Page[T], error)); ok {
+		return rf(cursor, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) specs.Page[T]); ok {
+		r0 = rf(cursor, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Page[T])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(cursor, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSeq provides a mock function with given fields:
+func (_m *FakeBuilder[T]) FindSeq() iter.Seq2[T, error] {
+	ret := _m.Called()
+
+	var r0 iter.Seq2[T, error]
+	if rf, ok := ret.Get(0).(func() iter.Seq2[T, error]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[T, error])
+		}
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: primaryKey
 func (_m *FakeBuilder[T]) Get(primaryKey interface{}) (T, error) {
 	ret := _m.Called(primaryKey)
@@ -186,6 +351,82 @@ func (_m *FakeBuilder[T]) Get(primaryKey interface{}) (T, error) {
 	return r0, r1
 }
 
+// Intersect provides a mock function with given fields: other
+func (_m *FakeBuilder[T]) Intersect(other specs.Builder[T]) specs.Builder[T] {
+	ret := _m.Called(other)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(specs.Builder[T]) specs.Builder[T]); ok {
+		r0 = rf(other)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// LockForShare provides a mock function with given fields: option
+func (_m *FakeBuilder[T]) LockForShare(option ...specs.LockOption) specs.Builder[T] {
+	_va := make([]interface{}, len(option))
+	for _i := range option {
+		_va[_i] = option[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(...specs.LockOption) specs.Builder[T]); ok {
+		r0 = rf(option...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// LockForUpdate provides a mock function with given fields: option
+func (_m *FakeBuilder[T]) LockForUpdate(option ...specs.LockOption) specs.Builder[T] {
+	_va := make([]interface{}, len(option))
+	for _i := range option {
+		_va[_i] = option[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(...specs.LockOption) specs.Builder[T]); ok {
+		r0 = rf(option...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// OnlyTrashed provides a mock function with given fields:
+func (_m *FakeBuilder[T]) OnlyTrashed() specs.Builder[T] {
+	ret := _m.Called()
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func() specs.Builder[T]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 // Payload provides a mock function with given fields:
 func (_m *FakeBuilder[T]) Payload() specs.PayloadAugmented[T] {
 	ret := _m.Called()
@@ -202,6 +443,44 @@ func (_m *FakeBuilder[T]) Payload() specs.PayloadAugmented[T] {
 	return r0
 }
 
+// SetAggregates provides a mock function with given fields: aggregates
+func (_m *FakeBuilder[T]) SetAggregates(aggregates ...specs.Aggregate) specs.Builder[T] {
+	_va := make([]interface{}, len(aggregates))
+	for _i := range aggregates {
+		_va[_i] = aggregates[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(...specs.Aggregate) specs.Builder[T]); ok {
+		r0 = rf(aggregates...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// SetDistinct provides a mock function with given fields:
+func (_m *FakeBuilder[T]) SetDistinct() specs.Builder[T] {
+	ret := _m.Called()
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func() specs.Builder[T]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 // SetFields provides a mock function with given fields: field
 func (_m *FakeBuilder[T]) SetFields(field ...string) specs.Builder[T] {
 	_va := make([]interface{}, len(field))
@@ -224,6 +503,44 @@ func (_m *FakeBuilder[T]) SetFields(field ...string) specs.Builder[T] {
 	return r0
 }
 
+// SetGroupBy provides a mock function with given fields: fields
+func (_m *FakeBuilder[T]) SetGroupBy(fields ...string) specs.Builder[T] {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(...string) specs.Builder[T]); ok {
+		r0 = rf(fields...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// SetHaving provides a mock function with given fields: condition
+func (_m *FakeBuilder[T]) SetHaving(condition specs.Condition) specs.Builder[T] {
+	ret := _m.Called(condition)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(specs.Condition) specs.Builder[T]); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 // SetLimit provides a mock function with given fields: limit
 func (_m *FakeBuilder[T]) SetLimit(limit int) specs.Builder[T] {
 	ret := _m.Called(limit)
@@ -310,6 +627,64 @@ func (_m *FakeBuilder[T]) SetWhere(condition specs.Condition) specs.Builder[T] {
 	return r0
 }
 
+// Subquery provides a mock function with given fields: index
+func (_m *FakeBuilder[T]) Subquery(index int) (specs.Payload, error) {
+	ret := _m.Called(index)
+
+	var r0 specs.Payload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (specs.Payload, error)); ok {
+		return rf(index)
+	}
+	if rf, ok := ret.Get(0).(func(int) specs.Payload); ok {
+		r0 = rf(index)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Union provides a mock function with given fields: other
+func (_m *FakeBuilder[T]) Union(other specs.Builder[T]) specs.Builder[T] {
+	ret := _m.Called(other)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(specs.Builder[T]) specs.Builder[T]); ok {
+		r0 = rf(other)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
+// UnionAll provides a mock function with given fields: other
+func (_m *FakeBuilder[T]) UnionAll(other specs.Builder[T]) specs.Builder[T] {
+	ret := _m.Called(other)
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func(specs.Builder[T]) specs.Builder[T]); ok {
+		r0 = rf(other)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields:
 func (_m *FakeBuilder[T]) Update() error {
 	ret := _m.Called()
@@ -324,6 +699,54 @@ func (_m *FakeBuilder[T]) Update() error {
 	return r0
 }
 
+// UpdateWhere provides a mock function with given fields: values
+func (_m *FakeBuilder[T]) UpdateWhere(values map[string]any) (int64, error) {
+	ret := _m.Called(values)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]any) (int64, error)); ok {
+		return rf(values)
+	}
+	if rf, ok := ret.Get(0).(func(map[string]any) int64); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(map[string]any) error); ok {
+		r1 = rf(values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields:
+func (_m *FakeBuilder[T]) Upsert() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Wheres provides a mock function with given fields:
 func (_m *FakeBuilder[T]) Wheres() []specs.Condition {
 	ret := _m.Called()
@@ -340,6 +763,22 @@ func (_m *FakeBuilder[T]) Wheres() []specs.Condition {
 	return r0
 }
 
+// WithTrashed provides a mock function with given fields:
+func (_m *FakeBuilder[T]) WithTrashed() specs.Builder[T] {
+	ret := _m.Called()
+
+	var r0 specs.Builder[T]
+	if rf, ok := ret.Get(0).(func() specs.Builder[T]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Builder[T])
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBuilder interface {
 	mock.TestingT
 	Cleanup(func())
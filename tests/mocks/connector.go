@@ -28,6 +28,56 @@ func (_m *FakeConnector) Config() specs.Config {
 	return r0
 }
 
+// Count provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Count(ctx context.Context, payload specs.Payload) (int64, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (int64, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) int64); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Delete(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields:
 func (_m *FakeConnector) Get() *sql.DB {
 	ret := _m.Called()
@@ -49,6 +99,58 @@ func (_m *FakeConnector) GetCnx(ctx context.Context) {
 	_m.Called(ctx)
 }
 
+// Insert provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Insert(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertMany provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) InsertMany(ctx context.Context, payload specs.Payload) ([]sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 []sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) ([]sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) []sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Name provides a mock function with given fields:
 func (_m *FakeConnector) Name() string {
 	ret := _m.Called()
@@ -77,6 +179,20 @@ func (_m *FakeConnector) New(_a0 specs.Config) error {
 	return r0
 }
 
+// Raw provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Raw(ctx context.Context, payload specs.PayloadRaw) error {
+	ret := _m.Called(ctx, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.PayloadRaw) error); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Select provides a mock function with given fields: ctx, payload
 func (_m *FakeConnector) Select(ctx context.Context, payload specs.Payload) error {
 	ret := _m.Called(ctx, payload)
@@ -107,6 +223,72 @@ func (_m *FakeConnector) SetName(name string) specs.Connector {
 	return r0
 }
 
+// Transaction provides a mock function with given fields: ctx, fn
+func (_m *FakeConnector) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Update(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, payload
+func (_m *FakeConnector) Upsert(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewConnector interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,6 +12,13 @@ type Driver interface {
 	Get() *sql.DB
 
 	Select(ctx context.Context, payload specs.Payload) error
+	Insert(ctx context.Context, payload specs.Payload) (sql.Result, error)
+	Upsert(ctx context.Context, payload specs.Payload) (sql.Result, error)
+	InsertMany(ctx context.Context, payload specs.Payload) ([]sql.Result, error)
+	Update(ctx context.Context, payload specs.Payload) (sql.Result, error)
+	Delete(ctx context.Context, payload specs.Payload) (sql.Result, error)
+	Count(ctx context.Context, payload specs.Payload) (int64, error)
+	Raw(ctx context.Context, payload specs.PayloadRaw) error
 }
 
 // FakeDriver is an autogenerated mock type for the FakeDriver type
@@ -19,6 +26,56 @@ type FakeDriver struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Count(ctx context.Context, payload specs.Payload) (int64, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (int64, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) int64); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Delete(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields:
 func (_m *FakeDriver) Get() *sql.DB {
 	ret := _m.Called()
@@ -35,6 +92,58 @@ func (_m *FakeDriver) Get() *sql.DB {
 	return r0
 }
 
+// Insert provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Insert(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertMany provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) InsertMany(ctx context.Context, payload specs.Payload) ([]sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 []sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) ([]sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) []sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // New provides a mock function with given fields: _a0
 func (_m *FakeDriver) New(_a0 specs.Config) error {
 	ret := _m.Called(_a0)
@@ -49,6 +158,20 @@ func (_m *FakeDriver) New(_a0 specs.Config) error {
 	return r0
 }
 
+// Raw provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Raw(ctx context.Context, payload specs.PayloadRaw) error {
+	ret := _m.Called(ctx, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.PayloadRaw) error); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Select provides a mock function with given fields: ctx, payload
 func (_m *FakeDriver) Select(ctx context.Context, payload specs.Payload) error {
 	ret := _m.Called(ctx, payload)
@@ -63,6 +186,58 @@ func (_m *FakeDriver) Select(ctx context.Context, payload specs.Payload) error {
 	return r0
 }
 
+// Update provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Update(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, payload
+func (_m *FakeDriver) Upsert(ctx context.Context, payload specs.Payload) (sql.Result, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 sql.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) (sql.Result, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.Payload) sql.Result); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewDriver interface {
 	mock.TestingT
 	Cleanup(func())
@@ -112,6 +112,28 @@ func (_m *FakeDriverJoin) SetTo(field specs.DriverField) specs.DriverJoin {
 	return r0
 }
 
+// SetWheres provides a mock function with given fields: wheres
+func (_m *FakeDriverJoin) SetWheres(wheres ...specs.DriverWhere) specs.DriverJoin {
+	_va := make([]interface{}, len(wheres))
+	for _i := range wheres {
+		_va[_i] = wheres[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.DriverJoin
+	if rf, ok := ret.Get(0).(func(...specs.DriverWhere) specs.DriverJoin); ok {
+		r0 = rf(wheres...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverJoin)
+		}
+	}
+
+	return r0
+}
+
 // To provides a mock function with given fields:
 func (_m *FakeDriverJoin) To() specs.DriverField {
 	ret := _m.Called()
@@ -142,6 +164,22 @@ func (_m *FakeDriverJoin) Validate() error {
 	return r0
 }
 
+// Wheres provides a mock function with given fields:
+func (_m *FakeDriverJoin) Wheres() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewDriverJoin interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,110 @@
+package mocks
+
+import (
+	specs "github.com/kitstack/dbkit/specs"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FakeDriverLock is an mock type for the FakeDriverLock type
+type FakeDriverLock struct {
+	mock.Mock
+}
+
+// Formatted provides a mock function with given fields:
+func (_m *FakeDriverLock) Formatted() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Option provides a mock function with given fields:
+func (_m *FakeDriverLock) Option() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SetOption provides a mock function with given fields: option
+func (_m *FakeDriverLock) SetOption(option specs.LockOption) specs.DriverLock {
+	ret := _m.Called(option)
+
+	var r0 specs.DriverLock
+	if rf, ok := ret.Get(0).(func(specs.LockOption) specs.DriverLock); ok {
+		r0 = rf(option)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLock)
+		}
+	}
+
+	return r0
+}
+
+// SetStrength provides a mock function with given fields: strength
+func (_m *FakeDriverLock) SetStrength(strength specs.LockStrength) specs.DriverLock {
+	ret := _m.Called(strength)
+
+	var r0 specs.DriverLock
+	if rf, ok := ret.Get(0).(func(specs.LockStrength) specs.DriverLock); ok {
+		r0 = rf(strength)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLock)
+		}
+	}
+
+	return r0
+}
+
+// Strength provides a mock function with given fields:
+func (_m *FakeDriverLock) Strength() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewDriverLock interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFakeDriverLock creates a new instance of FakeDriverLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFakeDriverLock(t mockConstructorTestingTNewDriverLock) *FakeDriverLock {
+	fakeDriverLock := &FakeDriverLock{}
+	fakeDriverLock.Mock.Test(t)
+
+	t.Cleanup(func() { fakeDriverLock.AssertExpectations(t) })
+
+	return fakeDriverLock
+}
@@ -0,0 +1,112 @@
+package mocks
+
+import (
+	specs "github.com/kitstack/dbkit/specs"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FakeDriverOrder is an mock type for the FakeDriverOrder type
+type FakeDriverOrder struct {
+	mock.Mock
+}
+
+// Direction provides a mock function with given fields:
+func (_m *FakeDriverOrder) Direction() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Field provides a mock function with given fields:
+func (_m *FakeDriverOrder) Field() specs.DriverField {
+	ret := _m.Called()
+
+	var r0 specs.DriverField
+	if rf, ok := ret.Get(0).(func() specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Formatted provides a mock function with given fields:
+func (_m *FakeDriverOrder) Formatted() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDirection provides a mock function with given fields: direction
+func (_m *FakeDriverOrder) SetDirection(direction specs.OrderDirection) specs.DriverOrder {
+	ret := _m.Called(direction)
+
+	var r0 specs.DriverOrder
+	if rf, ok := ret.Get(0).(func(specs.OrderDirection) specs.DriverOrder); ok {
+		r0 = rf(direction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverOrder)
+		}
+	}
+
+	return r0
+}
+
+// SetField provides a mock function with given fields: field
+func (_m *FakeDriverOrder) SetField(field specs.DriverField) specs.DriverOrder {
+	ret := _m.Called(field)
+
+	var r0 specs.DriverOrder
+	if rf, ok := ret.Get(0).(func(specs.DriverField) specs.DriverOrder); ok {
+		r0 = rf(field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverOrder)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewDriverOrder interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFakeDriverOrder creates a new instance of FakeDriverOrder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFakeDriverOrder(t mockConstructorTestingTNewDriverOrder) *FakeDriverOrder {
+	fakeDriverOrder := &FakeDriverOrder{}
+	fakeDriverOrder.Mock.Test(t)
+
+	t.Cleanup(func() { fakeDriverOrder.AssertExpectations(t) })
+
+	return fakeDriverOrder
+}
@@ -59,6 +59,20 @@ func (_m *FakeDriverWhere) From() specs.DriverField {
 	return r0
 }
 
+// IsGroup provides a mock function with given fields:
+func (_m *FakeDriverWhere) IsGroup() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Operator provides a mock function with given fields:
 func (_m *FakeDriverWhere) Operator() string {
 	ret := _m.Called()
@@ -121,6 +135,28 @@ func (_m *FakeDriverWhere) SetTo(to any) specs.DriverWhere {
 	return r0
 }
 
+// SetWheres provides a mock function with given fields: wheres
+func (_m *FakeDriverWhere) SetWheres(wheres ...specs.DriverWhere) specs.DriverWhere {
+	_va := make([]interface{}, len(wheres))
+	for _i := range wheres {
+		_va[_i] = wheres[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 specs.DriverWhere
+	if rf, ok := ret.Get(0).(func(...specs.DriverWhere) specs.DriverWhere); ok {
+		r0 = rf(wheres...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 // To provides a mock function with given fields:
 func (_m *FakeDriverWhere) To() any {
 	ret := _m.Called()
@@ -137,6 +173,22 @@ func (_m *FakeDriverWhere) To() any {
 	return r0
 }
 
+// Wheres provides a mock function with given fields:
+func (_m *FakeDriverWhere) Wheres() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewWhere interface {
 	mock.TestingT
 	Cleanup(func())
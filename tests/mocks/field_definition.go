@@ -11,6 +11,34 @@ type FakeFieldDefinition struct {
 	mock.Mock
 }
 
+// IsAutoCreateTime provides a mock function with given fields:
+func (_m *FakeFieldDefinition) IsAutoCreateTime() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsAutoUpdateTime provides a mock function with given fields:
+func (_m *FakeFieldDefinition) IsAutoUpdateTime() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsSlice provides a mock function with given fields:
 func (_m *FakeFieldDefinition) IsSlice() bool {
 	ret := _m.Called()
@@ -194,6 +222,34 @@ func (_m *FakeFieldDefinition) IsPrimaryKey() bool {
 	return r0
 }
 
+// IsSoftDelete provides a mock function with given fields:
+func (_m *FakeFieldDefinition) IsSoftDelete() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsVersion provides a mock function with given fields:
+func (_m *FakeFieldDefinition) IsVersion() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Join provides a mock function with given fields:
 func (_m *FakeFieldDefinition) Join() []specs.DriverJoin {
 	ret := _m.Called()
@@ -185,6 +185,38 @@ func (_m *FakeModelDefinition) GetPrimaryField() (specs.FieldDefinition, specs.E
 	return r0, r1
 }
 
+// GetSoftDeleteField provides a mock function with given fields:
+func (_m *FakeModelDefinition) GetSoftDeleteField() specs.FieldDefinition {
+	ret := _m.Called()
+
+	var r0 specs.FieldDefinition
+	if rf, ok := ret.Get(0).(func() specs.FieldDefinition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.FieldDefinition)
+		}
+	}
+
+	return r0
+}
+
+// GetVersionField provides a mock function with given fields:
+func (_m *FakeModelDefinition) GetVersionField() specs.FieldDefinition {
+	ret := _m.Called()
+
+	var r0 specs.FieldDefinition
+	if rf, ok := ret.Get(0).(func() specs.FieldDefinition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.FieldDefinition)
+		}
+	}
+
+	return r0
+}
+
 // Index provides a mock function with given fields:
 func (_m *FakeModelDefinition) Index() int {
 	ret := _m.Called()
@@ -10,6 +10,22 @@ type FakePayload struct {
 	mock.Mock
 }
 
+// Compounds provides a mock function with given fields:
+func (_m *FakePayload) Compounds() []specs.DriverCompound {
+	ret := _m.Called()
+
+	var r0 []specs.DriverCompound
+	if rf, ok := ret.Get(0).(func() []specs.DriverCompound); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverCompound)
+		}
+	}
+
+	return r0
+}
+
 // Database provides a mock function with given fields:
 func (_m *FakePayload) Database() string {
 	ret := _m.Called()
@@ -24,6 +40,20 @@ func (_m *FakePayload) Database() string {
 	return r0
 }
 
+// Distinct provides a mock function with given fields:
+func (_m *FakePayload) Distinct() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Fields provides a mock function with given fields:
 func (_m *FakePayload) Fields() []specs.DriverField {
 	ret := _m.Called()
@@ -40,6 +70,38 @@ func (_m *FakePayload) Fields() []specs.DriverField {
 	return r0
 }
 
+// GroupBy provides a mock function with given fields:
+func (_m *FakePayload) GroupBy() []specs.DriverField {
+	ret := _m.Called()
+
+	var r0 []specs.DriverField
+	if rf, ok := ret.Get(0).(func() []specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Having provides a mock function with given fields:
+func (_m *FakePayload) Having() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 // Index provides a mock function with given fields:
 func (_m *FakePayload) Index() int {
 	ret := _m.Called()
@@ -85,6 +147,22 @@ func (_m *FakePayload) Join() []specs.DriverJoin {
 	return r0
 }
 
+// Lock provides a mock function with given fields:
+func (_m *FakePayload) Lock() specs.DriverLock {
+	ret := _m.Called()
+
+	var r0 specs.DriverLock
+	if rf, ok := ret.Get(0).(func() specs.DriverLock); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLock)
+		}
+	}
+
+	return r0
+}
+
 // Mapping provides a mock function with given fields:
 func (_m *FakePayload) Mapping() ([]interface{}, error) {
 	ret := _m.Called()
@@ -125,6 +203,86 @@ func (_m *FakePayload) OnScan(_a0 []interface{}) error {
 	return r0
 }
 
+// OrderBy provides a mock function with given fields:
+func (_m *FakePayload) OrderBy() []specs.DriverOrder {
+	ret := _m.Called()
+
+	var r0 []specs.DriverOrder
+	if rf, ok := ret.Get(0).(func() []specs.DriverOrder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverOrder)
+		}
+	}
+
+	return r0
+}
+
+// PrimaryKey provides a mock function with given fields:
+func (_m *FakePayload) PrimaryKey() specs.DriverField {
+	ret := _m.Called()
+
+	var r0 specs.DriverField
+	if rf, ok := ret.Get(0).(func() specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Rows provides a mock function with given fields:
+func (_m *FakePayload) Rows() [][]specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 [][]specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() [][]specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+// SetCompounds provides a mock function with given fields: _a0
+func (_m *FakePayload) SetCompounds(_a0 []specs.DriverCompound) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverCompound) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetDistinct provides a mock function with given fields: _a0
+func (_m *FakePayload) SetDistinct(_a0 bool) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(bool) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
 // SetFields provides a mock function with given fields: _a0
 func (_m *FakePayload) SetFields(_a0 []specs.DriverField) specs.Payload {
 	ret := _m.Called(_a0)
@@ -141,6 +299,38 @@ func (_m *FakePayload) SetFields(_a0 []specs.DriverField) specs.Payload {
 	return r0
 }
 
+// SetGroupBy provides a mock function with given fields: _a0
+func (_m *FakePayload) SetGroupBy(_a0 []specs.DriverField) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverField) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetHaving provides a mock function with given fields: _a0
+func (_m *FakePayload) SetHaving(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
 // SetJoins provides a mock function with given fields: _a0
 func (_m *FakePayload) SetJoins(_a0 []specs.DriverJoin) specs.Payload {
 	ret := _m.Called(_a0)
@@ -157,6 +347,70 @@ func (_m *FakePayload) SetJoins(_a0 []specs.DriverJoin) specs.Payload {
 	return r0
 }
 
+// SetLock provides a mock function with given fields: _a0
+func (_m *FakePayload) SetLock(_a0 specs.DriverLock) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(specs.DriverLock) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetOrderBy provides a mock function with given fields: _a0
+func (_m *FakePayload) SetOrderBy(_a0 []specs.DriverOrder) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverOrder) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetRows provides a mock function with given fields: _a0
+func (_m *FakePayload) SetRows(_a0 [][]specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([][]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetValues provides a mock function with given fields: _a0
+func (_m *FakePayload) SetValues(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
 // SetWheres provides a mock function with given fields: _a0
 func (_m *FakePayload) SetWheres(_a0 []specs.DriverWhere) specs.Payload {
 	ret := _m.Called(_a0)
@@ -203,6 +457,22 @@ func (_m *FakePayload) Table() string {
 	return r0
 }
 
+// Values provides a mock function with given fields:
+func (_m *FakePayload) Values() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 // Where provides a mock function with given fields:
 func (_m *FakePayload) Where() []specs.DriverWhere {
 	ret := _m.Called()
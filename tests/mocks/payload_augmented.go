@@ -12,6 +12,22 @@ type FakePayloadAugmented[T specs.Model] struct {
 	mock.Mock
 }
 
+// Compounds provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Compounds() []specs.DriverCompound {
+	ret := _m.Called()
+
+	var r0 []specs.DriverCompound
+	if rf, ok := ret.Get(0).(func() []specs.DriverCompound); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverCompound)
+		}
+	}
+
+	return r0
+}
+
 // Database provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Database() string {
 	ret := _m.Called()
@@ -26,6 +42,20 @@ func (_m *FakePayloadAugmented[T]) Database() string {
 	return r0
 }
 
+// Distinct provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Distinct() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Fields provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Fields() []specs.DriverField {
 	ret := _m.Called()
@@ -42,6 +72,38 @@ func (_m *FakePayloadAugmented[T]) Fields() []specs.DriverField {
 	return r0
 }
 
+// GroupBy provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) GroupBy() []specs.DriverField {
+	ret := _m.Called()
+
+	var r0 []specs.DriverField
+	if rf, ok := ret.Get(0).(func() []specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Having provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Having() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 // Index provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Index() int {
 	ret := _m.Called()
@@ -88,6 +150,22 @@ func (_m *FakePayloadAugmented[T]) Limit() specs.DriverLimit {
 	return r0
 }
 
+// Lock provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Lock() specs.DriverLock {
+	ret := _m.Called()
+
+	var r0 specs.DriverLock
+	if rf, ok := ret.Get(0).(func() specs.DriverLock); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLock)
+		}
+	}
+
+	return r0
+}
+
 // Mapping provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Mapping() ([]interface{}, error) {
 	ret := _m.Called()
@@ -128,6 +206,38 @@ func (_m *FakePayloadAugmented[T]) OnScan(_a0 []interface{}) error {
 	return r0
 }
 
+// OrderBy provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) OrderBy() []specs.DriverOrder {
+	ret := _m.Called()
+
+	var r0 []specs.DriverOrder
+	if rf, ok := ret.Get(0).(func() []specs.DriverOrder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverOrder)
+		}
+	}
+
+	return r0
+}
+
+// PrimaryKey provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) PrimaryKey() specs.DriverField {
+	ret := _m.Called()
+
+	var r0 specs.DriverField
+	if rf, ok := ret.Get(0).(func() specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
 // Result provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Result() []T {
 	ret := _m.Called()
@@ -144,6 +254,70 @@ func (_m *FakePayloadAugmented[T]) Result() []T {
 	return r0
 }
 
+// Rows provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Rows() [][]specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 [][]specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() [][]specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+// SetCompounds provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetCompounds(_a0 []specs.DriverCompound) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverCompound) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetDistinct provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetDistinct(_a0 bool) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(bool) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetEach provides a mock function with given fields: each
+func (_m *FakePayloadAugmented[T]) SetEach(each func(T) error) specs.PayloadAugmented[T] {
+	ret := _m.Called(each)
+
+	var r0 specs.PayloadAugmented[T]
+	if rf, ok := ret.Get(0).(func(func(T) error) specs.PayloadAugmented[T]); ok {
+		r0 = rf(each)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.PayloadAugmented[T])
+		}
+	}
+
+	return r0
+}
+
 // SetFields provides a mock function with given fields: _a0
 func (_m *FakePayloadAugmented[T]) SetFields(_a0 []specs.DriverField) specs.Payload {
 	ret := _m.Called(_a0)
@@ -160,6 +334,38 @@ func (_m *FakePayloadAugmented[T]) SetFields(_a0 []specs.DriverField) specs.Payl
 	return r0
 }
 
+// SetGroupBy provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetGroupBy(_a0 []specs.DriverField) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverField) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetHaving provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetHaving(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
 // SetJoins provides a mock function with given fields: _a0
 func (_m *FakePayloadAugmented[T]) SetJoins(_a0 []specs.DriverJoin) specs.Payload {
 	ret := _m.Called(_a0)
@@ -192,6 +398,70 @@ func (_m *FakePayloadAugmented[T]) SetLimit(_a0 specs.DriverLimit) specs.Payload
 	return r0
 }
 
+// SetLock provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetLock(_a0 specs.DriverLock) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(specs.DriverLock) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetOrderBy provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetOrderBy(_a0 []specs.DriverOrder) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverOrder) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetRows provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetRows(_a0 [][]specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([][]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetValues provides a mock function with given fields: _a0
+func (_m *FakePayloadAugmented[T]) SetValues(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
 // SetWheres provides a mock function with given fields: _a0
 func (_m *FakePayloadAugmented[T]) SetWheres(_a0 []specs.DriverWhere) specs.Payload {
 	ret := _m.Called(_a0)
@@ -222,6 +492,22 @@ func (_m *FakePayloadAugmented[T]) Table() string {
 	return r0
 }
 
+// Values provides a mock function with given fields:
+func (_m *FakePayloadAugmented[T]) Values() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
 // Where provides a mock function with given fields:
 func (_m *FakePayloadAugmented[T]) Where() []specs.DriverWhere {
 	ret := _m.Called()
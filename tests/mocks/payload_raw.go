@@ -0,0 +1,549 @@
+package mocks
+
+import (
+	"github.com/kitstack/dbkit/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+// FakePayloadRaw is an autogenerated mock type for the FakePayloadRaw type
+type FakePayloadRaw struct {
+	mock.Mock
+}
+
+// Args provides a mock function with given fields:
+func (_m *FakePayloadRaw) Args() []any {
+	ret := _m.Called()
+
+	var r0 []any
+	if rf, ok := ret.Get(0).(func() []any); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]any)
+		}
+	}
+
+	return r0
+}
+
+// Compounds provides a mock function with given fields:
+func (_m *FakePayloadRaw) Compounds() []specs.DriverCompound {
+	ret := _m.Called()
+
+	var r0 []specs.DriverCompound
+	if rf, ok := ret.Get(0).(func() []specs.DriverCompound); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverCompound)
+		}
+	}
+
+	return r0
+}
+
+// Database provides a mock function with given fields:
+func (_m *FakePayloadRaw) Database() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Distinct provides a mock function with given fields:
+func (_m *FakePayloadRaw) Distinct() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fields provides a mock function with given fields:
+func (_m *FakePayloadRaw) Fields() []specs.DriverField {
+	ret := _m.Called()
+
+	var r0 []specs.DriverField
+	if rf, ok := ret.Get(0).(func() []specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// GroupBy provides a mock function with given fields:
+func (_m *FakePayloadRaw) GroupBy() []specs.DriverField {
+	ret := _m.Called()
+
+	var r0 []specs.DriverField
+	if rf, ok := ret.Get(0).(func() []specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Having provides a mock function with given fields:
+func (_m *FakePayloadRaw) Having() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+// Index provides a mock function with given fields:
+func (_m *FakePayloadRaw) Index() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+func (_m *FakePayloadRaw) Limit() specs.DriverLimit {
+	ret := _m.Called()
+
+	var r0 specs.DriverLimit
+	if rf, ok := ret.Get(0).(func() specs.DriverLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLimit)
+		}
+	}
+
+	return r0
+}
+
+// Join provides a mock function with given fields:
+func (_m *FakePayloadRaw) Join() []specs.DriverJoin {
+	ret := _m.Called()
+
+	var r0 []specs.DriverJoin
+	if rf, ok := ret.Get(0).(func() []specs.DriverJoin); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverJoin)
+		}
+	}
+
+	return r0
+}
+
+// Lock provides a mock function with given fields:
+func (_m *FakePayloadRaw) Lock() specs.DriverLock {
+	ret := _m.Called()
+
+	var r0 specs.DriverLock
+	if rf, ok := ret.Get(0).(func() specs.DriverLock); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverLock)
+		}
+	}
+
+	return r0
+}
+
+// Mapping provides a mock function with given fields:
+func (_m *FakePayloadRaw) Mapping() ([]interface{}, error) {
+	ret := _m.Called()
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OnScan provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) OnScan(_a0 []interface{}) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]interface{}) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderBy provides a mock function with given fields:
+func (_m *FakePayloadRaw) OrderBy() []specs.DriverOrder {
+	ret := _m.Called()
+
+	var r0 []specs.DriverOrder
+	if rf, ok := ret.Get(0).(func() []specs.DriverOrder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverOrder)
+		}
+	}
+
+	return r0
+}
+
+// PrimaryKey provides a mock function with given fields:
+func (_m *FakePayloadRaw) PrimaryKey() specs.DriverField {
+	ret := _m.Called()
+
+	var r0 specs.DriverField
+	if rf, ok := ret.Get(0).(func() specs.DriverField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.DriverField)
+		}
+	}
+
+	return r0
+}
+
+// Query provides a mock function with given fields:
+func (_m *FakePayloadRaw) Query() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Rows provides a mock function with given fields:
+func (_m *FakePayloadRaw) Rows() [][]specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 [][]specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() [][]specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+// SetColumns provides a mock function with given fields: columns
+func (_m *FakePayloadRaw) SetColumns(columns []string) error {
+	ret := _m.Called(columns)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(columns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCompounds provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetCompounds(_a0 []specs.DriverCompound) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverCompound) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetDistinct provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetDistinct(_a0 bool) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(bool) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetFields provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetFields(_a0 []specs.DriverField) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverField) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetGroupBy provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetGroupBy(_a0 []specs.DriverField) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverField) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetHaving provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetHaving(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetJoins provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetJoins(_a0 []specs.DriverJoin) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverJoin) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetLock provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetLock(_a0 specs.DriverLock) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(specs.DriverLock) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetOrderBy provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetOrderBy(_a0 []specs.DriverOrder) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverOrder) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetRows provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetRows(_a0 [][]specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([][]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetValues provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetValues(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetWheres provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetWheres(_a0 []specs.DriverWhere) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func([]specs.DriverWhere) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// SetLimit provides a mock function with given fields: _a0
+func (_m *FakePayloadRaw) SetLimit(_a0 specs.DriverLimit) specs.Payload {
+	ret := _m.Called(_a0)
+
+	var r0 specs.Payload
+	if rf, ok := ret.Get(0).(func(specs.DriverLimit) specs.Payload); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(specs.Payload)
+		}
+	}
+
+	return r0
+}
+
+// Table provides a mock function with given fields:
+func (_m *FakePayloadRaw) Table() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Values provides a mock function with given fields:
+func (_m *FakePayloadRaw) Values() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+// Where provides a mock function with given fields:
+func (_m *FakePayloadRaw) Where() []specs.DriverWhere {
+	ret := _m.Called()
+
+	var r0 []specs.DriverWhere
+	if rf, ok := ret.Get(0).(func() []specs.DriverWhere); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.DriverWhere)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewPayloadRaw interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFakePayloadRaw creates a new instance of FakePayloadRaw. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFakePayloadRaw(t mockConstructorTestingTNewPayloadRaw) *FakePayloadRaw {
+	fakePayloadRaw := &FakePayloadRaw{}
+	fakePayloadRaw.Mock.Test(t)
+
+	t.Cleanup(func() { fakePayloadRaw.AssertExpectations(t) })
+
+	return fakePayloadRaw
+}
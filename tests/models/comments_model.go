@@ -9,8 +9,8 @@ type CommentsModel struct {
 	Post    PostsModel     `dbKit:"column:post_id, foreignKey:id"`
 	Parent  *CommentsModel `dbKit:"column:parent_id, foreignKey:id"`
 	Content string         `dbKit:"column:content"`
-	Created time.Time      `dbKit:"column:created_at"`
-	Updated time.Time      `dbKit:"column:updated_at"`
+	Created time.Time      `dbKit:"column:created_at, autoCreateTime"`
+	Updated time.Time      `dbKit:"column:updated_at, autoUpdateTime"`
 }
 
 func (s *CommentsModel) DatabaseName() string {
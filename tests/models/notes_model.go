@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+type NotesModel struct {
+	Id      uint        `dbKit:"column:id, primaryKey"`
+	User    UsersModel  `dbKit:"column:user_id, foreignKey:id"`
+	Parent  *NotesModel `dbKit:"column:parent_id, foreignKey:id"`
+	Content string      `dbKit:"column:content"`
+	Deleted *time.Time  `dbKit:"column:deleted_at, softDelete"`
+}
+
+func (s *NotesModel) DatabaseName() string {
+	return "acceptance"
+}
+
+func (s *NotesModel) TableName() string {
+	return "notes"
+}
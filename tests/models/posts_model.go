@@ -9,8 +9,9 @@ type PostsModel struct {
 	Comments []CommentsModel `dbKit:"column:id, foreignKey:post_id"`
 	Title    string          `dbKit:"column:title"`
 	Content  string          `dbKit:"column:content"`
-	Created  time.Time       `dbKit:"column:created_at"`
-	Updated  time.Time       `dbKit:"column:updated_at"`
+	Created  time.Time       `dbKit:"column:created_at, autoCreateTime"`
+	Updated  time.Time       `dbKit:"column:updated_at, autoUpdateTime"`
+	Version  uint            `dbKit:"column:version, version"`
 }
 
 func (s *PostsModel) DatabaseName() string {
@@ -7,8 +7,8 @@ type UsersModel struct {
 	Email     string     `dbKit:"column:email"`
 	Password  string     `dbKit:"column:password"`
 	Validated bool       `dbKit:"column:validated"`
-	CreatedAt *time.Time `dbKit:"column:created_at"`
-	UpdatedAt *time.Time `dbKit:"column:updated_at"`
+	CreatedAt *time.Time `dbKit:"column:created_at, autoCreateTime"`
+	UpdatedAt *time.Time `dbKit:"column:updated_at, autoUpdateTime"`
 
 	BadTag  string `dbKit:"column:bad_tag:bad_tag, "`
 	private bool